@@ -0,0 +1,73 @@
+package postmark
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrForbiddenAttachment is returned by the attachment constructors for file types Postmark refuses
+var ErrForbiddenAttachment = errors.New("postmark: forbidden attachment type")
+
+// NewAttachmentFromFile reads the file at path into an Attachment named after the file
+func NewAttachmentFromFile(path string) (Attachment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Attachment{}, err
+	}
+	return NewAttachment(filepath.Base(path), data)
+}
+
+// NewAttachmentFromReader reads r into an Attachment called name
+func NewAttachmentFromReader(name string, r io.Reader) (Attachment, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Attachment{}, err
+	}
+	return NewAttachment(name, data)
+}
+
+// NewAttachment encodes data into an Attachment called name. The
+// ContentType is guessed from the extension of name, or else from data.
+func NewAttachment(name string, data []byte) (Attachment, error) {
+	if forbiddenAttachment(name) {
+		return Attachment{}, fmt.Errorf("%w %q", ErrForbiddenAttachment, name)
+	}
+	return Attachment{
+		Name:        name,
+		Content:     base64.StdEncoding.EncodeToString(data),
+		ContentType: detectContentType(name, data),
+	}, nil
+}
+
+// NewInlineImage encodes an image to embed in the HtmlBody, where it's
+// referenced as <img src="cid:...">, with the given content ID
+func NewInlineImage(name string, data []byte, contentID string) (Attachment, error) {
+	attachment, err := NewAttachment(name, data)
+	if err != nil {
+		return attachment, err
+	}
+	if !strings.HasPrefix(attachment.ContentType, "image/") {
+		return Attachment{}, fmt.Errorf("postmark: %q is not an image (%s)", name, attachment.ContentType)
+	}
+	if !strings.HasPrefix(contentID, "cid:") {
+		contentID = "cid:" + contentID
+	}
+	attachment.ContentID = contentID
+	return attachment, nil
+}
+
+// detectContentType guesses the MIME type of a file from its extension,
+// falling back to sniffing its content
+func detectContentType(name string, data []byte) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return http.DetectContentType(data)
+}
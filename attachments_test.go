@@ -0,0 +1,74 @@
+package postmark
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// 1x1 transparent GIF
+var gifData = []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\x00\x00\x00\x00\x00\x00!\xf9\x04\x01\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+
+func TestNewAttachmentFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "readme.txt")
+	if err := os.WriteFile(path, []byte("test content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	attachment, err := NewAttachmentFromFile(path)
+	if err != nil {
+		t.Fatalf("NewAttachmentFromFile: %s", err.Error())
+	}
+
+	if attachment.Name != "readme.txt" || attachment.Content != "dGVzdCBjb250ZW50" {
+		t.Fatalf("NewAttachmentFromFile: wrong attachment %v", attachment)
+	}
+
+	if !strings.HasPrefix(attachment.ContentType, "text/plain") {
+		t.Fatalf("NewAttachmentFromFile: wrong ContentType %s", attachment.ContentType)
+	}
+
+	if _, err := NewAttachmentFromFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatalf("NewAttachmentFromFile: should have failed on a missing file")
+	}
+}
+
+func TestNewAttachmentFromReader(t *testing.T) {
+	// No known extension, so the type is sniffed
+	attachment, err := NewAttachmentFromReader("pixel", strings.NewReader(string(gifData)))
+	if err != nil {
+		t.Fatalf("NewAttachmentFromReader: %s", err.Error())
+	}
+
+	if attachment.ContentType != "image/gif" {
+		t.Fatalf("NewAttachmentFromReader: wrong ContentType %s", attachment.ContentType)
+	}
+
+	_, err = NewAttachmentFromReader("setup.exe", strings.NewReader("MZ"))
+	if !errors.Is(err, ErrForbiddenAttachment) {
+		t.Fatalf("NewAttachmentFromReader: should have rejected an exe, got %v", err)
+	}
+
+	for _, name := range []string{"bundle.js", "tool.jar", "install.PS1"} {
+		if _, err := NewAttachment(name, []byte("...")); !errors.Is(err, ErrForbiddenAttachment) {
+			t.Fatalf("NewAttachment: should have rejected %s, got %v", name, err)
+		}
+	}
+}
+
+func TestNewInlineImage(t *testing.T) {
+	image, err := NewInlineImage("pixel.gif", gifData, "pixel")
+	if err != nil {
+		t.Fatalf("NewInlineImage: %s", err.Error())
+	}
+
+	if image.ContentID != "cid:pixel" || image.ContentType != "image/gif" {
+		t.Fatalf("NewInlineImage: wrong image %v", image)
+	}
+
+	if _, err := NewInlineImage("notes.txt", []byte("hello"), "cid:notes"); err == nil {
+		t.Fatalf("NewInlineImage: should have rejected text")
+	}
+}
@@ -0,0 +1,268 @@
+package postmark
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Postmark's batch limits
+const (
+	// MaxBatchMessages is the maximum number of messages in a single batch request
+	MaxBatchMessages = 500
+	// MaxBatchSize is the maximum size of a single batch request
+	MaxBatchSize = 50 * 1024 * 1024
+)
+
+// BatchItem is the outcome of a single message of a batch
+type BatchItem[T any] struct {
+	// Index: Position of the message in the batch
+	Index int
+	// Message: The message as it was passed in
+	Message T
+	// Response: Postmark's response for the message. Empty if its request failed.
+	Response EmailResponse
+	// Err: Why the message wasn't accepted, nil if it was. Either an APIError
+	// built from the response's ErrorCode, the error of the whole request, or
+	// the message's validation errors if Client.ValidateSends is set.
+	Err error
+
+	// requestFailed is set when Err is the error of the whole request
+	requestFailed bool
+}
+
+// BatchResult pairs each message of a batch send with its outcome
+//
+//	result := client.SendEmailBatchResult(emails)
+//	if err := result.Err(); err != nil {
+//		log.Print(err)
+//		result = client.SendEmailBatchResult(result.FailedMessages())
+//	}
+type BatchResult[T any] struct {
+	// Items: One per message, in the order they were passed in
+	Items []BatchItem[T]
+
+	// requestErrs are the errors of the failed requests, one per failed chunk
+	requestErrs []chunkError
+}
+
+type chunkError struct {
+	start int
+	err   error
+}
+
+// Succeeded returns the items of the messages Postmark accepted
+func (result BatchResult[T]) Succeeded() []BatchItem[T] {
+	var items []BatchItem[T]
+	for _, item := range result.Items {
+		if item.Err == nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Failed returns the items of the messages that weren't accepted
+func (result BatchResult[T]) Failed() []BatchItem[T] {
+	var items []BatchItem[T]
+	for _, item := range result.Items {
+		if item.Err != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// FailedMessages returns the messages that weren't accepted, ready to be sent again
+func (result BatchResult[T]) FailedMessages() []T {
+	var messages []T
+	for _, item := range result.Items {
+		if item.Err != nil {
+			messages = append(messages, item.Message)
+		}
+	}
+	return messages
+}
+
+// Responses returns the responses, lined up with the messages
+func (result BatchResult[T]) Responses() []EmailResponse {
+	res := make([]EmailResponse, len(result.Items))
+	for i, item := range result.Items {
+		res[i] = item.Response
+	}
+	return res
+}
+
+// Err joins the errors of the failed requests and of the rejected messages
+// with errors.Join, or returns nil if every message was accepted
+func (result BatchResult[T]) Err() error {
+	errs := result.sortedRequestErrs()
+	for _, item := range result.Items {
+		if item.Err != nil && !item.requestFailed {
+			errs = append(errs, fmt.Errorf("message %d: %w", item.Index, item.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// batchChunk is a part of a batch small enough for a single request.
+// indexes are the positions of its messages in the whole batch.
+type batchChunk[T any] struct {
+	indexes  []int
+	messages []T
+}
+
+// chunkBatch splits the messages at indexes into chunks within Postmark's batch limits
+func chunkBatch[T any](messages []T, indexes []int) ([]batchChunk[T], error) {
+	var (
+		chunks []batchChunk[T]
+		chunk  batchChunk[T]
+		size   int
+	)
+	for _, i := range indexes {
+		payload, err := json.Marshal(messages[i])
+		if err != nil {
+			return nil, err
+		}
+		// A comma between messages, and room for the brackets and wrapper
+		messageSize := len(payload) + 1
+
+		if len(chunk.messages) > 0 && (len(chunk.messages) == MaxBatchMessages || size+messageSize > MaxBatchSize-64) {
+			chunks = append(chunks, chunk)
+			chunk, size = batchChunk[T]{}, 0
+		}
+		chunk.indexes = append(chunk.indexes, i)
+		chunk.messages = append(chunk.messages, messages[i])
+		size += messageSize
+	}
+	if len(chunk.messages) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks, nil
+}
+
+// validatable is a message with a Validate method, for Client.ValidateSends
+type validatable interface {
+	Validate() error
+}
+
+// sendBatch sends messages in chunks within Postmark's batch limits, up to
+// client.BatchConcurrency chunks at a time. With client.ValidateSends,
+// invalid messages are left out.
+func sendBatch[T validatable](ctx context.Context, client *Client, messages []T, send func(context.Context, []T) ([]EmailResponse, error)) BatchResult[T] {
+	result := BatchResult[T]{Items: make([]BatchItem[T], len(messages))}
+	indexes := make([]int, 0, len(messages))
+	for i, message := range messages {
+		result.Items[i] = BatchItem[T]{Index: i, Message: message}
+		if client.ValidateSends {
+			if err := message.Validate(); err != nil {
+				result.Items[i].Err = err
+				continue
+			}
+		}
+		indexes = append(indexes, i)
+	}
+
+	chunks, err := chunkBatch(messages, indexes)
+	if err != nil {
+		result.fail(batchChunk[T]{indexes: indexes, messages: messages}, err)
+		return result
+	}
+
+	concurrency := client.BatchConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for _, chunk := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			result.fail(chunk, ctx.Err())
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(chunk batchChunk[T]) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			res, err := send(ctx, chunk.messages)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil && len(res) != len(chunk.messages) {
+				// Responses can't be matched to messages
+				err = fmt.Errorf("postmark: %d responses for %d messages", len(res), len(chunk.messages))
+			}
+			if err != nil {
+				result.fail(chunk, err)
+				return
+			}
+			for i := range chunk.messages {
+				item := &result.Items[chunk.indexes[i]]
+				item.Response = res[i]
+				if res[i].ErrorCode != 0 {
+					item.Err = APIError{ErrorCode: res[i].ErrorCode, Message: res[i].Message}
+				}
+			}
+		}(chunk)
+	}
+	wg.Wait()
+
+	return result
+}
+
+// fail records the error of the request sending chunk
+func (result *BatchResult[T]) fail(chunk batchChunk[T], err error) {
+	if len(chunk.indexes) == 0 {
+		return
+	}
+	start, end := chunk.indexes[0], chunk.indexes[len(chunk.indexes)-1]
+	result.requestErrs = append(result.requestErrs, chunkError{
+		start: start,
+		err:   fmt.Errorf("messages %d-%d: %w", start, end, err),
+	})
+	for _, i := range chunk.indexes {
+		result.Items[i].Err = err
+		result.Items[i].requestFailed = true
+	}
+}
+
+// batchError returns the errors of the failed requests and of the messages
+// that weren't sent, leaving rejected messages to be found in their responses
+func (result BatchResult[T]) batchError() error {
+	errs := result.sortedRequestErrs()
+	for _, item := range result.Items {
+		if item.Err != nil && !item.requestFailed && item.Response.ErrorCode == 0 {
+			errs = append(errs, fmt.Errorf("message %d: %w", item.Index, item.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sortedRequestErrs returns the errors of the failed requests in the order
+// of their chunks, which finish in any order
+func (result BatchResult[T]) sortedRequestErrs() []error {
+	chunkErrs := append([]chunkError(nil), result.requestErrs...)
+	sort.Slice(chunkErrs, func(i, j int) bool { return chunkErrs[i].start < chunkErrs[j].start })
+
+	errs := make([]error, len(chunkErrs))
+	for i, chunkErr := range chunkErrs {
+		errs[i] = chunkErr.err
+	}
+	return errs
+}
@@ -0,0 +1,228 @@
+package postmark
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSendEmailBatchChunking(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		emails := []Email{}
+		json.NewDecoder(req.Body).Decode(&emails)
+
+		mu.Lock()
+		requests = append(requests, len(emails))
+		mu.Unlock()
+
+		// The first message of the second chunk fails the whole request
+		if emails[0].To == "500@example.com" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"ErrorCode": 300, "Message": "Invalid email request"}`))
+			return
+		}
+
+		res := make([]EmailResponse, len(emails))
+		for i, email := range emails {
+			res[i] = EmailResponse{To: email.To, MessageID: "id-" + email.To}
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.BaseURL = server.URL
+	client.BatchConcurrency = 3
+
+	emails := make([]Email, 1201)
+	for i := range emails {
+		emails[i] = Email{To: fmt.Sprintf("%d@example.com", i)}
+	}
+
+	res, err := client.SendEmailBatch(emails)
+	if err == nil {
+		t.Fatalf("SendEmailBatch: the failed chunk should be reported")
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("SendEmailBatch: wrong number of requests %v", requests)
+	}
+
+	if len(res) != len(emails) {
+		t.Fatalf("SendEmailBatch: wrong number of responses (%d)", len(res))
+	}
+
+	for i, email := range emails {
+		sent := i < 500 || i >= 1000
+		if sent && res[i].MessageID != "id-"+email.To {
+			t.Fatalf("SendEmailBatch: response %d doesn't line up: %v", i, res[i])
+		}
+		if !sent && res[i].MessageID != "" {
+			t.Fatalf("SendEmailBatch: response %d should be empty: %v", i, res[i])
+		}
+	}
+}
+
+func TestChunkBatchSize(t *testing.T) {
+	body := strings.Repeat("a", 20*1024*1024)
+	emails := []Email{{TextBody: body}, {TextBody: body}, {TextBody: body}, {To: "small@example.com"}}
+
+	chunks, err := chunkBatch(emails, []int{0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("chunkBatch: %s", err.Error())
+	}
+
+	if len(chunks) != 2 || chunks[0].indexes[0] != 0 || len(chunks[0].messages) != 2 || chunks[1].indexes[0] != 2 || len(chunks[1].messages) != 2 {
+		t.Fatalf("chunkBatch: wrong chunks")
+	}
+
+	if chunks, _ := chunkBatch([]Email{}, nil); len(chunks) != 0 {
+		t.Fatalf("chunkBatch: an empty batch has no chunks")
+	}
+}
+
+func TestSendEmailBatchResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		emails := []Email{}
+		json.NewDecoder(req.Body).Decode(&emails)
+
+		res := make([]EmailResponse, len(emails))
+		for i, email := range emails {
+			res[i] = EmailResponse{To: email.To, MessageID: "id-" + email.To}
+			if strings.HasPrefix(email.To, "inactive") {
+				res[i] = EmailResponse{To: email.To, ErrorCode: ErrCodeInactiveRecipient, Message: "Inactive recipient"}
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.BaseURL = server.URL
+
+	result := client.SendEmailBatchResult([]Email{
+		{To: "one@example.com"},
+		{To: "inactive@example.com"},
+		{To: "two@example.com"},
+	})
+
+	if len(result.Succeeded()) != 2 || len(result.Failed()) != 1 {
+		t.Fatalf("SendEmailBatchResult: wrong outcomes %v", result.Items)
+	}
+
+	failed := result.Failed()[0]
+	if failed.Index != 1 || failed.Message.To != "inactive@example.com" {
+		t.Fatalf("SendEmailBatchResult: wrong failed item %v", failed)
+	}
+
+	var apiErr APIError
+	if !errors.As(result.Err(), &apiErr) || apiErr.ErrorCode != ErrCodeInactiveRecipient {
+		t.Fatalf("SendEmailBatchResult: wrong error %v", result.Err())
+	}
+
+	retry := result.FailedMessages()
+	if len(retry) != 1 || retry[0].To != "inactive@example.com" {
+		t.Fatalf("SendEmailBatchResult: wrong messages to retry %v", retry)
+	}
+
+	// Rejected messages are in the responses, not the error
+	res, err := client.SendEmailBatch([]Email{{To: "inactive@example.com"}})
+	if err != nil || res[0].ErrorCode != ErrCodeInactiveRecipient {
+		t.Fatalf("SendEmailBatch: wrong outcome %v (%v)", res, err)
+	}
+
+	if err := client.SendEmailBatchResult([]Email{{To: "one@example.com"}}).Err(); err != nil {
+		t.Fatalf("SendEmailBatchResult: unexpected error %v", err)
+	}
+}
+
+func TestBatchResultRequestFailure(t *testing.T) {
+	client := NewClient("", "")
+	client.BaseURL = "http://127.0.0.1:0"
+
+	result := client.SendTemplatedEmailBatchResult([]TemplatedEmail{testTemplatedEmail, testTemplatedEmail})
+
+	if len(result.Failed()) != 2 || result.Items[1].Err == nil {
+		t.Fatalf("SendTemplatedEmailBatchResult: every message should have failed")
+	}
+
+	// The request's error is reported once, not once per message
+	joined, ok := result.Err().(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 1 {
+		t.Fatalf("SendTemplatedEmailBatchResult: wrong error %v", result.Err())
+	}
+}
+
+func TestSendEmailBatchMissingResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`[{"To": "one@example.com", "MessageID": "id-one"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.BaseURL = server.URL
+
+	res, err := client.SendEmailBatch([]Email{{To: "one@example.com"}, {To: "two@example.com"}})
+	if err == nil {
+		t.Fatalf("SendEmailBatch: unacknowledged messages should be reported")
+	}
+
+	if len(res) != 2 || res[1].MessageID != "" {
+		t.Fatalf("SendEmailBatch: wrong responses %v", res)
+	}
+}
+
+func TestSendEmailBatchValidateSends(t *testing.T) {
+	var sent []Email
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		json.NewDecoder(req.Body).Decode(&sent)
+
+		res := make([]EmailResponse, len(sent))
+		for i, email := range sent {
+			res[i] = EmailResponse{To: email.To, MessageID: "id-" + email.To}
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.BaseURL = server.URL
+	client.ValidateSends = true
+
+	emails := []Email{
+		{From: "sender@example.com", To: "one@example.com", TextBody: "Hi"},
+		{From: "sender@example.com", TextBody: "Hi"},
+		{From: "sender@example.com", To: "two@example.com", TextBody: "Hi"},
+	}
+
+	result := client.SendEmailBatchResult(emails)
+
+	if len(sent) != 2 || sent[0].To != "one@example.com" || sent[1].To != "two@example.com" {
+		t.Fatalf("SendEmailBatchResult: the invalid message shouldn't be sent %v", sent)
+	}
+
+	if result.Items[2].Response.MessageID != "id-two@example.com" {
+		t.Fatalf("SendEmailBatchResult: responses don't line up %v", result.Items)
+	}
+
+	var fieldErr FieldError
+	failed := result.Failed()
+	if len(failed) != 1 || failed[0].Index != 1 || !errors.As(failed[0].Err, &fieldErr) {
+		t.Fatalf("SendEmailBatchResult: wrong failed items %v", failed)
+	}
+
+	// Unsent messages are reported by the error, unlike rejected ones
+	res, err := client.SendEmailBatch(emails)
+	if !errors.As(err, &fieldErr) || len(res) != 3 || res[1].MessageID != "" {
+		t.Fatalf("SendEmailBatch: wrong outcome %v (%v)", res, err)
+	}
+}
@@ -1,9 +1,11 @@
 package postmark
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -31,9 +33,14 @@ type DeliveryStats struct {
 
 // GetDeliveryStats returns delivery stats for the server
 func (client *Client) GetDeliveryStats() (DeliveryStats, error) {
+	return client.GetDeliveryStatsContext(context.Background())
+}
+
+// GetDeliveryStatsContext is the context-aware version of GetDeliveryStats.
+func (client *Client) GetDeliveryStatsContext(ctx context.Context) (DeliveryStats, error) {
 	res := DeliveryStats{}
 	path := "deliverystats"
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      path,
 		TokenType: server_token,
@@ -74,6 +81,84 @@ type Bounce struct {
 	CanActivate bool
 	// Subject: Email subject
 	Subject string
+	// MessageStream: ID of the message stream the bounced message was sent through
+	MessageStream string
+}
+
+// Bounce types
+// http://developer.postmarkapp.com/developer-api-bounce.html#bounce-types
+const (
+	BounceTypeHardBounce              = "HardBounce"
+	BounceTypeTransient               = "Transient"
+	BounceTypeUnsubscribe             = "Unsubscribe"
+	BounceTypeSubscribe               = "Subscribe"
+	BounceTypeAutoResponder           = "AutoResponder"
+	BounceTypeAddressChange           = "AddressChange"
+	BounceTypeDnsError                = "DnsError"
+	BounceTypeSpamNotification        = "SpamNotification"
+	BounceTypeOpenRelayTest           = "OpenRelayTest"
+	BounceTypeUnknown                 = "Unknown"
+	BounceTypeSoftBounce              = "SoftBounce"
+	BounceTypeVirusNotification       = "VirusNotification"
+	BounceTypeChallengeVerification   = "ChallengeVerification"
+	BounceTypeBadEmailAddress         = "BadEmailAddress"
+	BounceTypeSpamComplaint           = "SpamComplaint"
+	BounceTypeManuallyDeactivated     = "ManuallyDeactivated"
+	BounceTypeUnconfirmed             = "Unconfirmed"
+	BounceTypeBlocked                 = "Blocked"
+	BounceTypeSMTPApiError            = "SMTPApiError"
+	BounceTypeInboundError            = "InboundError"
+	BounceTypeDMARCPolicy             = "DMARCPolicy"
+	BounceTypeTemplateRenderingFailed = "TemplateRenderingFailed"
+)
+
+// BounceSearch filters the bounces returned by GetBounces. Zero values are ignored.
+type BounceSearch struct {
+	// Type: Filter by bounce type, one of the BounceType... constants
+	Type string
+	// Inactive: Filter by emails that were deactivated by Postmark due to the bounce
+	Inactive *bool
+	// EmailFilter: Filter by email address
+	EmailFilter string
+	// Tag: Filter by tag
+	Tag string
+	// MessageID: Filter by messageID
+	MessageID string
+	// FromDate: Filter messages starting from the date specified (inclusive)
+	FromDate time.Time
+	// ToDate: Filter messages up to the date specified (inclusive)
+	ToDate time.Time
+	// MessageStream: Filter by message stream ID
+	MessageStream string
+}
+
+func (search BounceSearch) values() (url.Values, error) {
+	err := checkEnum("bounce type", search.Type,
+		BounceTypeHardBounce, BounceTypeTransient, BounceTypeUnsubscribe, BounceTypeSubscribe,
+		BounceTypeAutoResponder, BounceTypeAddressChange, BounceTypeDnsError, BounceTypeSpamNotification,
+		BounceTypeOpenRelayTest, BounceTypeUnknown, BounceTypeSoftBounce, BounceTypeVirusNotification,
+		BounceTypeChallengeVerification, BounceTypeBadEmailAddress, BounceTypeSpamComplaint,
+		BounceTypeManuallyDeactivated, BounceTypeUnconfirmed, BounceTypeBlocked, BounceTypeSMTPApiError,
+		BounceTypeInboundError, BounceTypeDMARCPolicy, BounceTypeTemplateRenderingFailed)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDateRange(search.FromDate, search.ToDate); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	setString(values, "type", search.Type)
+	if search.Inactive != nil {
+		values.Set("inactive", strconv.FormatBool(*search.Inactive))
+	}
+	setString(values, "emailFilter", search.EmailFilter)
+	setString(values, "tag", search.Tag)
+	setString(values, "messageID", search.MessageID)
+	setTime(values, "fromdate", search.FromDate)
+	setTime(values, "todate", search.ToDate)
+	setString(values, "messagestream", search.MessageStream)
+	return values, nil
 }
 
 type bouncesResponse struct {
@@ -84,20 +169,24 @@ type bouncesResponse struct {
 // GetBounces returns bounces for the server
 // It returns a Bounce slice, the total bounce count, and any error that occurred
 // Available options: http://developer.postmarkapp.com/developer-api-bounce.html#bounces
-func (client *Client) GetBounces(count int64, offset int64, options map[string]interface{}) ([]Bounce, int64, error) {
+func (client *Client) GetBounces(count int64, offset int64, search BounceSearch) ([]Bounce, int64, error) {
+	return client.GetBouncesContext(context.Background(), count, offset, search)
+}
+
+// GetBouncesContext is the context-aware version of GetBounces.
+func (client *Client) GetBouncesContext(ctx context.Context, count int64, offset int64, search BounceSearch) ([]Bounce, int64, error) {
 	res := bouncesResponse{}
 
-	values := &url.Values{}
+	values, err := search.values()
+	if err != nil {
+		return nil, 0, err
+	}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
-	}
-
 	path := fmt.Sprintf("bounces?%s", values.Encode())
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      path,
 		TokenType: server_token,
@@ -105,14 +194,26 @@ func (client *Client) GetBounces(count int64, offset int64, options map[string]i
 	return res.Bounces, res.TotalCount, err
 }
 
+// IterBounces returns an Iterator over every bounce matching search
+func (client *Client) IterBounces(ctx context.Context, search BounceSearch) *Iterator[Bounce] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]Bounce, int64, error) {
+		return client.GetBouncesContext(ctx, count, offset, search)
+	})
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
 // GetBounce fetches a single bounce with bounceID
 func (client *Client) GetBounce(bounceID int64) (Bounce, error) {
+	return client.GetBounceContext(context.Background(), bounceID)
+}
+
+// GetBounceContext is the context-aware version of GetBounce.
+func (client *Client) GetBounceContext(ctx context.Context, bounceID int64) (Bounce, error) {
 	res := Bounce{}
 	path := fmt.Sprintf("bounces/%v", bounceID)
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      path,
 		TokenType: server_token,
@@ -129,9 +230,14 @@ type dumpResponse struct {
 
 // GetBounceDump fetches a SMTP data dump for a single bounce
 func (client *Client) GetBounceDump(bounceID int64) (string, error) {
+	return client.GetBounceDumpContext(context.Background(), bounceID)
+}
+
+// GetBounceDumpContext is the context-aware version of GetBounceDump.
+func (client *Client) GetBounceDumpContext(ctx context.Context, bounceID int64) (string, error) {
 	res := dumpResponse{}
 	path := fmt.Sprintf("bounces/%v/dump", bounceID)
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      path,
 		TokenType: server_token,
@@ -151,9 +257,14 @@ type activateBounceResponse struct {
 // message, and any error that occurs
 // TODO: clarify this with Postmark
 func (client *Client) ActivateBounce(bounceID int64) (Bounce, string, error) {
+	return client.ActivateBounceContext(context.Background(), bounceID)
+}
+
+// ActivateBounceContext is the context-aware version of ActivateBounce.
+func (client *Client) ActivateBounceContext(ctx context.Context, bounceID int64) (Bounce, string, error) {
 	res := activateBounceResponse{}
 	path := fmt.Sprintf("bounces/%v/activate", bounceID)
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "PUT",
 		Path:      path,
 		TokenType: server_token,
@@ -170,9 +281,14 @@ type bouncedTagsResponse struct {
 
 // GetBouncedTags retrieves a list of tags that have generated bounced emails
 func (client *Client) GetBouncedTags() ([]string, error) {
+	return client.GetBouncedTagsContext(context.Background())
+}
+
+// GetBouncedTagsContext is the context-aware version of GetBouncedTags.
+func (client *Client) GetBouncedTagsContext(ctx context.Context) ([]string, error) {
 	var raw json.RawMessage
 	path := "bounces/tags"
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      path,
 		TokenType: server_token,
@@ -104,8 +104,8 @@ func TestGetBounces(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	_, total, err := client.GetBounces(100, 0, map[string]interface{}{
-		"tag": "Invitation",
+	_, total, err := client.GetBounces(100, 0, BounceSearch{
+		Tag: "Invitation",
 	})
 
 	if err != nil {
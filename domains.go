@@ -0,0 +1,260 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Domain represents a sending domain in your Postmark account and its DNS setup
+// ListDomains only populates Name, ID and the verification flags
+type Domain struct {
+	// ID: ID of domain
+	ID int64
+	// Name: Domain name
+	Name string
+	// SPFVerified: Whether the SPF DNS record has been verified
+	SPFVerified bool
+	// SPFHost: Host name used for the SPF configuration
+	SPFHost string
+	// SPFTextValue: Value that can be optionally set up with your DNS host
+	SPFTextValue string
+	// DKIMVerified: Whether DKIM has been verified
+	DKIMVerified bool
+	// WeakDKIM: Whether DKIM is using a 1024 bit key instead of the stronger 2048 bit key
+	WeakDKIM bool
+	// DKIMHost: DNS TXT host being used to validate messages sent in
+	DKIMHost string
+	// DKIMTextValue: DNS TXT value being used to validate messages sent in
+	DKIMTextValue string
+	// DKIMPendingHost: Pending DKIM DNS TXT host, when a new key is being set up
+	DKIMPendingHost string
+	// DKIMPendingTextValue: Pending DKIM DNS TXT value, when a new key is being set up
+	DKIMPendingTextValue string
+	// DKIMRevokedHost: DKIM DNS TXT host of the key being phased out
+	DKIMRevokedHost string
+	// DKIMRevokedTextValue: DKIM DNS TXT value of the key being phased out
+	DKIMRevokedTextValue string
+	// SafeToRemoveRevokedKeyFromDNS: Whether the revoked DKIM record can be removed from DNS
+	SafeToRemoveRevokedKeyFromDNS bool
+	// DKIMUpdateStatus: Status of a DKIM renewal, Pending or Verified
+	DKIMUpdateStatus string
+	// ReturnPathDomain: The custom Return-Path domain
+	ReturnPathDomain string
+	// ReturnPathDomainVerified: Whether the Return-Path domain's CNAME record has been verified
+	ReturnPathDomainVerified bool
+	// ReturnPathDomainCNAMEValue: The CNAME DNS record value the Return-Path domain should point to
+	ReturnPathDomainCNAMEValue string
+}
+
+// DomainCreate holds the details of a new domain
+type DomainCreate struct {
+	// Name: REQUIRED Domain name
+	Name string
+	// ReturnPathDomain: A custom Return-Path domain, a subdomain of Name with a CNAME to pm.mtasv.net
+	ReturnPathDomain string `json:",omitempty"`
+}
+
+// DomainEdit holds the editable details of a domain
+type DomainEdit struct {
+	// ReturnPathDomain: A custom Return-Path domain, a subdomain of the domain with a CNAME to pm.mtasv.net
+	ReturnPathDomain string
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type domainsResponse struct {
+	TotalCount int64
+	Domains    []Domain
+}
+
+// ListDomains fetches a list of domains in the account, limited by count and paged by offset
+// It returns a Domain slice, the total domain count, and any error that occurred
+func (client *Client) ListDomains(count int64, offset int64) ([]Domain, int64, error) {
+	return client.ListDomainsContext(context.Background(), count, offset)
+}
+
+// ListDomainsContext is the context-aware version of ListDomains.
+func (client *Client) ListDomainsContext(ctx context.Context, count int64, offset int64) ([]Domain, int64, error) {
+	res := domainsResponse{}
+
+	values := &url.Values{}
+	values.Add("count", fmt.Sprintf("%d", count))
+	values.Add("offset", fmt.Sprintf("%d", offset))
+
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("domains?%s", values.Encode()),
+		TokenType: account_token,
+	}, &res)
+	return res.Domains, res.TotalCount, err
+}
+
+// IterDomains returns an Iterator over every domain in the account
+func (client *Client) IterDomains(ctx context.Context) *Iterator[Domain] {
+	return newIterator(ctx, client.ListDomainsContext)
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// GetDomain fetches the full details of a domain with domainID, including its DNS setup
+func (client *Client) GetDomain(domainID int64) (Domain, error) {
+	return client.GetDomainContext(context.Background(), domainID)
+}
+
+// GetDomainContext is the context-aware version of GetDomain.
+func (client *Client) GetDomainContext(ctx context.Context, domainID int64) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("domains/%d", domainID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// CreateDomain adds a new domain to the account
+func (client *Client) CreateDomain(domain DomainCreate) (Domain, error) {
+	return client.CreateDomainContext(context.Background(), domain)
+}
+
+// CreateDomainContext is the context-aware version of CreateDomain.
+func (client *Client) CreateDomainContext(ctx context.Context, domain DomainCreate) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      "domains",
+		Payload:   domain,
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// EditDomain updates details for a specific domain with domainID
+func (client *Client) EditDomain(domainID int64, domain DomainEdit) (Domain, error) {
+	return client.EditDomainContext(context.Background(), domainID, domain)
+}
+
+// EditDomainContext is the context-aware version of EditDomain.
+func (client *Client) EditDomainContext(ctx context.Context, domainID int64, domain DomainEdit) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      fmt.Sprintf("domains/%d", domainID),
+		Payload:   domain,
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// DeleteDomain removes a domain (with domainID) from the account
+func (client *Client) DeleteDomain(domainID int64) error {
+	return client.DeleteDomainContext(context.Background(), domainID)
+}
+
+// DeleteDomainContext is the context-aware version of DeleteDomain.
+func (client *Client) DeleteDomainContext(ctx context.Context, domainID int64) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "DELETE",
+		Path:      fmt.Sprintf("domains/%d", domainID),
+		TokenType: account_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// VerifyDKIM asks Postmark to verify the DKIM record of a domain with domainID.
+// Check DKIMVerified on the returned domain.
+func (client *Client) VerifyDKIM(domainID int64) (Domain, error) {
+	return client.VerifyDKIMContext(context.Background(), domainID)
+}
+
+// VerifyDKIMContext is the context-aware version of VerifyDKIM.
+func (client *Client) VerifyDKIMContext(ctx context.Context, domainID int64) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      fmt.Sprintf("domains/%d/verifyDkim", domainID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// VerifyReturnPath asks Postmark to verify the Return-Path CNAME record of a domain with domainID.
+// Check ReturnPathDomainVerified on the returned domain.
+func (client *Client) VerifyReturnPath(domainID int64) (Domain, error) {
+	return client.VerifyReturnPathContext(context.Background(), domainID)
+}
+
+// VerifyReturnPathContext is the context-aware version of VerifyReturnPath.
+func (client *Client) VerifyReturnPathContext(ctx context.Context, domainID int64) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      fmt.Sprintf("domains/%d/verifyReturnPath", domainID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// VerifySPF asks Postmark to verify the SPF record of a domain with domainID.
+// Check SPFVerified on the returned domain.
+func (client *Client) VerifySPF(domainID int64) (Domain, error) {
+	return client.VerifySPFContext(context.Background(), domainID)
+}
+
+// VerifySPFContext is the context-aware version of VerifySPF.
+func (client *Client) VerifySPFContext(ctx context.Context, domainID int64) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("domains/%d/verifyspf", domainID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// RotateDKIM creates a new DKIM key for a domain with domainID.
+// Until the new DNS record is verified the domain keeps using the old key.
+func (client *Client) RotateDKIM(domainID int64) (Domain, error) {
+	return client.RotateDKIMContext(context.Background(), domainID)
+}
+
+// RotateDKIMContext is the context-aware version of RotateDKIM.
+func (client *Client) RotateDKIMContext(ctx context.Context, domainID int64) (Domain, error) {
+	res := Domain{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("domains/%d/rotatedkim", domainID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
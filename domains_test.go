@@ -0,0 +1,201 @@
+package postmark
+
+import (
+	"net/http"
+	"testing"
+
+	"goji.io/pat"
+)
+
+var domainJSON = `{
+  "Name": "wildbit.com",
+  "SPFVerified": true,
+  "SPFHost": "wildbit.com",
+  "SPFTextValue": "v=spf1 a mx include:spf.mtasv.net ~all",
+  "DKIMVerified": true,
+  "WeakDKIM": false,
+  "DKIMHost": "20131031155228pm._domainkey.wildbit.com",
+  "DKIMTextValue": "k=rsa;p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCFn...",
+  "DKIMPendingHost": "",
+  "DKIMPendingTextValue": "",
+  "DKIMRevokedHost": "",
+  "DKIMRevokedTextValue": "",
+  "SafeToRemoveRevokedKeyFromDNS": false,
+  "DKIMUpdateStatus": "Verified",
+  "ReturnPathDomain": "pmbounces.wildbit.com",
+  "ReturnPathDomainVerified": true,
+  "ReturnPathDomainCNAMEValue": "pm.mtasv.net",
+  "ID": 36735
+}`
+
+func TestListDomains(t *testing.T) {
+	responseJSON := `{
+	  "TotalCount": 2,
+	  "Domains": [
+		{
+		  "Name": "wildbit.com",
+		  "SPFVerified": true,
+		  "DKIMVerified": true,
+		  "WeakDKIM": false,
+		  "ReturnPathDomainVerified": false,
+		  "ID": 36735
+		},
+		{
+		  "Name": "example.com",
+		  "SPFVerified": true,
+		  "DKIMVerified": true,
+		  "WeakDKIM": false,
+		  "ReturnPathDomainVerified": true,
+		  "ID": 81605
+		}
+	  ]
+	}`
+
+	tMux.HandleFunc(pat.Get("/domains"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, total, err := client.ListDomains(50, 0)
+	if err != nil {
+		t.Fatalf("ListDomains: %s", err.Error())
+	}
+
+	if total != 2 || len(res) != 2 {
+		t.Fatalf("ListDomains: wrong total (%d)", total)
+	}
+
+	if res[1].Name != "example.com" {
+		t.Fatalf("ListDomains: wrong name!: %s", res[1].Name)
+	}
+}
+
+func TestGetDomain(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/domains/:domainID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(domainJSON))
+	})
+
+	res, err := client.GetDomain(36735)
+	if err != nil {
+		t.Fatalf("GetDomain: %s", err.Error())
+	}
+
+	if res.DKIMHost != "20131031155228pm._domainkey.wildbit.com" {
+		t.Fatalf("GetDomain: wrong DKIMHost!: %s", res.DKIMHost)
+	}
+}
+
+func TestCreateDomain(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/domains"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(domainJSON))
+	})
+
+	res, err := client.CreateDomain(DomainCreate{
+		Name:             "wildbit.com",
+		ReturnPathDomain: "pmbounces.wildbit.com",
+	})
+	if err != nil {
+		t.Fatalf("CreateDomain: %s", err.Error())
+	}
+
+	if res.ID != 36735 {
+		t.Fatalf("CreateDomain: wrong ID!: %d", res.ID)
+	}
+}
+
+func TestEditDomain(t *testing.T) {
+	tMux.HandleFunc(pat.Put("/domains/:domainID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(domainJSON))
+	})
+
+	res, err := client.EditDomain(36735, DomainEdit{
+		ReturnPathDomain: "pmbounces.wildbit.com",
+	})
+	if err != nil {
+		t.Fatalf("EditDomain: %s", err.Error())
+	}
+
+	if res.ReturnPathDomain != "pmbounces.wildbit.com" {
+		t.Fatalf("EditDomain: wrong ReturnPathDomain!: %s", res.ReturnPathDomain)
+	}
+}
+
+func TestDeleteDomain(t *testing.T) {
+	responseJSON := `{
+	  "ErrorCode": 0,
+	  "Message": "Domain example.com removed."
+	}`
+
+	tMux.HandleFunc(pat.Delete("/domains/:domainID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	// Success
+	err := client.DeleteDomain(36735)
+	if err != nil {
+		t.Fatalf("DeleteDomain: %s", err.Error())
+	}
+
+	// Failure
+	responseJSON = `{
+	  "ErrorCode": 402,
+	  "Message": "Invalid JSON"
+	}`
+
+	err = client.DeleteDomain(36735)
+	if err == nil {
+		t.Fatalf("DeleteDomain should have failed")
+	}
+}
+
+func TestVerifyDomain(t *testing.T) {
+	tMux.HandleFunc(pat.Put("/domains/:domainID/verifyDkim"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(domainJSON))
+	})
+	tMux.HandleFunc(pat.Put("/domains/:domainID/verifyReturnPath"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(domainJSON))
+	})
+	tMux.HandleFunc(pat.Post("/domains/:domainID/verifyspf"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(domainJSON))
+	})
+
+	res, err := client.VerifyDKIM(36735)
+	if err != nil || !res.DKIMVerified {
+		t.Fatalf("VerifyDKIM: %v %v", res.DKIMVerified, err)
+	}
+
+	res, err = client.VerifyReturnPath(36735)
+	if err != nil || !res.ReturnPathDomainVerified {
+		t.Fatalf("VerifyReturnPath: %v %v", res.ReturnPathDomainVerified, err)
+	}
+
+	res, err = client.VerifySPF(36735)
+	if err != nil || !res.SPFVerified {
+		t.Fatalf("VerifySPF: %v %v", res.SPFVerified, err)
+	}
+}
+
+func TestRotateDKIM(t *testing.T) {
+	responseJSON := `{
+	  "Name": "wildbit.com",
+	  "DKIMVerified": true,
+	  "WeakDKIM": false,
+	  "DKIMHost": "20131031155228pm._domainkey.wildbit.com",
+	  "DKIMPendingHost": "20150214155228pm._domainkey.wildbit.com",
+	  "DKIMPendingTextValue": "k=rsa;p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCFn...",
+	  "DKIMUpdateStatus": "Pending",
+	  "ID": 36735
+	}`
+
+	tMux.HandleFunc(pat.Post("/domains/:domainID/rotatedkim"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.RotateDKIM(36735)
+	if err != nil {
+		t.Fatalf("RotateDKIM: %s", err.Error())
+	}
+
+	if res.DKIMUpdateStatus != "Pending" {
+		t.Fatalf("RotateDKIM: wrong DKIMUpdateStatus!: %s", res.DKIMUpdateStatus)
+	}
+}
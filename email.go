@@ -1,7 +1,10 @@
 package postmark
 
 import (
-	"fmt"
+	"context"
+	"encoding/base64"
+	"io"
+	"strings"
 	"time"
 )
 
@@ -29,10 +32,14 @@ type Email struct {
 	Headers []Header `json:",omitempty"`
 	// TrackOpens: Activate open tracking for this email.
 	TrackOpens bool `json:",omitempty"`
+	// TrackLinks: Activate link tracking for this email, one of the TrackLinks... constants. Defaults to the server's setting.
+	TrackLinks string `json:",omitempty"`
 	// Attachments: List of attachments
 	Attachments []Attachment `json:",omitempty"`
 	// Metadata: metadata
 	Metadata map[string]string `json:",omitempty"`
+	// MessageStream: ID of the message stream to send through. Defaults to the server's transactional stream.
+	MessageStream string `json:",omitempty"`
 }
 
 // Header - an email header
@@ -55,6 +62,16 @@ type Attachment struct {
 	ContentID string `json:",omitempty"`
 }
 
+// Bytes decodes the attachment's Content
+func (a Attachment) Bytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(a.Content)
+}
+
+// Reader returns a reader decoding the attachment's Content as it's read
+func (a Attachment) Reader() io.Reader {
+	return base64.NewDecoder(base64.StdEncoding, strings.NewReader(a.Content))
+}
+
 // EmailResponse holds info in response to a send/send-batch request
 // Even if API request comes back successful, check the ErrorCode to see if there might be a delivery problem
 type EmailResponse struct {
@@ -72,8 +89,19 @@ type EmailResponse struct {
 
 // SendEmail sends, well, an email.
 func (client *Client) SendEmail(email Email) (EmailResponse, error) {
+	return client.SendEmailContext(context.Background(), email)
+}
+
+// SendEmailContext is the context-aware version of SendEmail.
+func (client *Client) SendEmailContext(ctx context.Context, email Email) (EmailResponse, error) {
 	res := EmailResponse{}
-	err := client.doRequest(parameters{
+	if client.ValidateSends {
+		if err := email.Validate(); err != nil {
+			return res, err
+		}
+	}
+
+	err := client.doRequest(ctx, parameters{
 		Method:    "POST",
 		Path:      "email",
 		Payload:   email,
@@ -81,7 +109,7 @@ func (client *Client) SendEmail(email Email) (EmailResponse, error) {
 	}, &res)
 
 	if res.ErrorCode != 0 {
-		return res, fmt.Errorf(`%v %s`, res.ErrorCode, res.Message)
+		return res, APIError{ErrorCode: res.ErrorCode, Message: res.Message}
 	}
 
 	return res, err
@@ -90,9 +118,33 @@ func (client *Client) SendEmail(email Email) (EmailResponse, error) {
 // SendEmailBatch sends multiple emails together
 // Note, individual emails in the batch can error, so it would be wise to
 // range over the responses and sniff for errors
+// Batches over Postmark's limits (MaxBatchMessages and MaxBatchSize) are split
+// into several requests. The responses line up with emails either way.
 func (client *Client) SendEmailBatch(emails []Email) ([]EmailResponse, error) {
+	return client.SendEmailBatchContext(context.Background(), emails)
+}
+
+// SendEmailBatchContext is the context-aware version of SendEmailBatch.
+func (client *Client) SendEmailBatchContext(ctx context.Context, emails []Email) ([]EmailResponse, error) {
+	result := sendBatch(ctx, client, emails, client.sendEmailBatch)
+	return result.Responses(), result.batchError()
+}
+
+// SendEmailBatchResult sends multiple emails together like SendEmailBatch, pairing
+// each email with its response. Check the result's Err, and send FailedMessages again.
+func (client *Client) SendEmailBatchResult(emails []Email) BatchResult[Email] {
+	return client.SendEmailBatchResultContext(context.Background(), emails)
+}
+
+// SendEmailBatchResultContext is the context-aware version of SendEmailBatchResult.
+func (client *Client) SendEmailBatchResultContext(ctx context.Context, emails []Email) BatchResult[Email] {
+	return sendBatch(ctx, client, emails, client.sendEmailBatch)
+}
+
+// sendEmailBatch sends a single batch request
+func (client *Client) sendEmailBatch(ctx context.Context, emails []Email) ([]EmailResponse, error) {
 	res := []EmailResponse{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "POST",
 		Path:      "email/batch",
 		Payload:   emails,
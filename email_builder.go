@@ -0,0 +1,149 @@
+package postmark
+
+import (
+	"net/mail"
+	"strings"
+)
+
+// EmailBuilder builds an Email from structured addresses, quoting and
+// encoding display names so commas, quotes and non-ASCII characters
+// don't break the headers Postmark renders
+//
+//	email := postmark.NewEmailBuilder().
+//		From(mail.Address{Name: "Acme, Inc.", Address: "no-reply@acme.com"}).
+//		To(mail.Address{Name: "Zoë", Address: "zoe@example.com"}).
+//		Subject("Reset your password").
+//		TextBody("...").
+//		Build()
+type EmailBuilder struct {
+	email Email
+	to    []mail.Address
+	cc    []mail.Address
+	bcc   []mail.Address
+}
+
+// NewEmailBuilder builds a new, empty EmailBuilder pointer
+func NewEmailBuilder() *EmailBuilder {
+	return &EmailBuilder{}
+}
+
+// From sets the sender
+func (b *EmailBuilder) From(address mail.Address) *EmailBuilder {
+	b.email.From = address.String()
+	return b
+}
+
+// To adds recipients
+func (b *EmailBuilder) To(addresses ...mail.Address) *EmailBuilder {
+	b.to = append(b.to, addresses...)
+	return b
+}
+
+// Cc adds Cc recipients
+func (b *EmailBuilder) Cc(addresses ...mail.Address) *EmailBuilder {
+	b.cc = append(b.cc, addresses...)
+	return b
+}
+
+// Bcc adds Bcc recipients
+func (b *EmailBuilder) Bcc(addresses ...mail.Address) *EmailBuilder {
+	b.bcc = append(b.bcc, addresses...)
+	return b
+}
+
+// ReplyTo overrides the reply-to address of the sender signature
+func (b *EmailBuilder) ReplyTo(address mail.Address) *EmailBuilder {
+	b.email.ReplyTo = address.String()
+	return b
+}
+
+// Subject sets the subject
+func (b *EmailBuilder) Subject(subject string) *EmailBuilder {
+	b.email.Subject = subject
+	return b
+}
+
+// HtmlBody sets the HTML body
+func (b *EmailBuilder) HtmlBody(body string) *EmailBuilder {
+	b.email.HtmlBody = body
+	return b
+}
+
+// TextBody sets the plain text body
+func (b *EmailBuilder) TextBody(body string) *EmailBuilder {
+	b.email.TextBody = body
+	return b
+}
+
+// Tag sets the tag
+func (b *EmailBuilder) Tag(tag string) *EmailBuilder {
+	b.email.Tag = tag
+	return b
+}
+
+// Header adds a custom header
+func (b *EmailBuilder) Header(name string, value string) *EmailBuilder {
+	b.email.Headers = append(b.email.Headers, Header{Name: name, Value: value})
+	return b
+}
+
+// Metadata sets a metadata key
+func (b *EmailBuilder) Metadata(key string, value string) *EmailBuilder {
+	if b.email.Metadata == nil {
+		b.email.Metadata = map[string]string{}
+	}
+	b.email.Metadata[key] = value
+	return b
+}
+
+// Attach adds attachments
+func (b *EmailBuilder) Attach(attachments ...Attachment) *EmailBuilder {
+	b.email.Attachments = append(b.email.Attachments, attachments...)
+	return b
+}
+
+// TrackOpens sets open tracking
+func (b *EmailBuilder) TrackOpens(track bool) *EmailBuilder {
+	b.email.TrackOpens = track
+	return b
+}
+
+// TrackLinks sets link tracking, one of the TrackLinks... constants
+func (b *EmailBuilder) TrackLinks(track string) *EmailBuilder {
+	b.email.TrackLinks = track
+	return b
+}
+
+// MessageStream sets the message stream to send through
+func (b *EmailBuilder) MessageStream(streamID string) *EmailBuilder {
+	b.email.MessageStream = streamID
+	return b
+}
+
+// Build renders the Email. The builder can keep being used afterwards
+// without changing the returned Email.
+func (b *EmailBuilder) Build() Email {
+	email := b.email
+	email.To = joinAddresses(b.to)
+	email.Cc = joinAddresses(b.cc)
+	email.Bcc = joinAddresses(b.bcc)
+
+	email.Headers = append([]Header(nil), b.email.Headers...)
+	email.Attachments = append([]Attachment(nil), b.email.Attachments...)
+	if b.email.Metadata != nil {
+		email.Metadata = make(map[string]string, len(b.email.Metadata))
+		for key, value := range b.email.Metadata {
+			email.Metadata[key] = value
+		}
+	}
+	return email
+}
+
+// joinAddresses renders addresses as a comma separated list
+func joinAddresses(addresses []mail.Address) string {
+	rendered := make([]string, len(addresses))
+	for i, address := range addresses {
+		rendered[i] = address.String()
+	}
+	return strings.Join(rendered, ", ")
+}
@@ -0,0 +1,42 @@
+package postmark
+
+import (
+	"net/mail"
+	"testing"
+)
+
+func TestEmailBuilder(t *testing.T) {
+	builder := NewEmailBuilder().
+		From(mail.Address{Name: "Acme, Inc.", Address: "no-reply@acme.com"}).
+		To(mail.Address{Name: `John "JD" Doe`, Address: "john@example.com"}, mail.Address{Address: "jane@example.com"}).
+		Cc(Recipient{Name: "Zoë", Email: "zoe@example.com"}.Address()).
+		Subject("Reset your password").
+		TextBody("...").
+		Header("X-Priority", "1").
+		Metadata("user", "42").
+		TrackLinks(TrackLinksHtmlOnly)
+
+	email := builder.Build()
+
+	if email.From != `"Acme, Inc." <no-reply@acme.com>` {
+		t.Fatalf("EmailBuilder: wrong From: %s", email.From)
+	}
+
+	if email.To != `"John \"JD\" Doe" <john@example.com>, <jane@example.com>` {
+		t.Fatalf("EmailBuilder: wrong To: %s", email.To)
+	}
+
+	if email.Cc != "=?utf-8?q?Zo=C3=AB?= <zoe@example.com>" {
+		t.Fatalf("EmailBuilder: wrong Cc: %s", email.Cc)
+	}
+
+	if email.Bcc != "" || email.Subject != "Reset your password" || email.TrackLinks != TrackLinksHtmlOnly {
+		t.Fatalf("EmailBuilder: wrong fields: %v", email)
+	}
+
+	// The rendered Email doesn't change with the builder
+	builder.Header("X-Other", "2").Metadata("user", "43")
+	if len(email.Headers) != 1 || email.Metadata["user"] != "42" {
+		t.Fatalf("EmailBuilder: Email changed after Build: %v", email)
+	}
+}
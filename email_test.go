@@ -1,6 +1,8 @@
 package postmark
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"testing"
 
@@ -110,3 +112,13 @@ func TestSendEmailBatch(t *testing.T) {
 		t.Fatalf("SendEmailBatch: wrong response array size!")
 	}
 }
+
+func TestSendEmailContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.SendEmailContext(ctx, testEmail)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("SendEmailContext: expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// InboundRule blocks inbound messages from an email address or a whole domain
+type InboundRule struct {
+	// ID: ID of the rule
+	ID int64 `json:",omitempty"`
+	// Rule: The blocked email address or domain
+	Rule string
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type inboundRulesResponse struct {
+	TotalCount   int64
+	InboundRules []InboundRule
+}
+
+// ListInboundRules fetches the server's inbound rules, limited by count and paged by offset
+// It returns an InboundRule slice, the total rule count, and any error that occurred
+func (client *Client) ListInboundRules(count int64, offset int64) ([]InboundRule, int64, error) {
+	return client.ListInboundRulesContext(context.Background(), count, offset)
+}
+
+// ListInboundRulesContext is the context-aware version of ListInboundRules.
+func (client *Client) ListInboundRulesContext(ctx context.Context, count int64, offset int64) ([]InboundRule, int64, error) {
+	res := inboundRulesResponse{}
+
+	values := &url.Values{}
+	values.Add("count", fmt.Sprintf("%d", count))
+	values.Add("offset", fmt.Sprintf("%d", offset))
+
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("triggers/inboundrules?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res.InboundRules, res.TotalCount, err
+}
+
+// IterInboundRules returns an Iterator over every inbound rule of the server
+func (client *Client) IterInboundRules(ctx context.Context) *Iterator[InboundRule] {
+	return newIterator(ctx, client.ListInboundRulesContext)
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// CreateInboundRule blocks inbound messages from rule, an email address or a domain
+func (client *Client) CreateInboundRule(rule string) (InboundRule, error) {
+	return client.CreateInboundRuleContext(context.Background(), rule)
+}
+
+// CreateInboundRuleContext is the context-aware version of CreateInboundRule.
+func (client *Client) CreateInboundRuleContext(ctx context.Context, rule string) (InboundRule, error) {
+	res := InboundRule{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      "triggers/inboundrules",
+		Payload:   InboundRule{Rule: rule},
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// DeleteInboundRule removes an inbound rule with ruleID from the server
+func (client *Client) DeleteInboundRule(ruleID int64) error {
+	return client.DeleteInboundRuleContext(context.Background(), ruleID)
+}
+
+// DeleteInboundRuleContext is the context-aware version of DeleteInboundRule.
+func (client *Client) DeleteInboundRuleContext(ctx context.Context, ruleID int64) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "DELETE",
+		Path:      fmt.Sprintf("triggers/inboundrules/%d", ruleID),
+		TokenType: server_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
@@ -0,0 +1,90 @@
+package postmark
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"goji.io/pat"
+)
+
+func TestListInboundRules(t *testing.T) {
+	responseJSON := `{
+	  "TotalCount": 2,
+	  "InboundRules": [
+		{
+		  "ID": 3,
+		  "Rule": "someone@example.com"
+		},
+		{
+		  "ID": 5,
+		  "Rule": "badsender.com"
+		}
+	  ]
+	}`
+
+	tMux.HandleFunc(pat.Get("/triggers/inboundrules"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, total, err := client.ListInboundRules(50, 0)
+	if err != nil {
+		t.Fatalf("ListInboundRules: %s", err.Error())
+	}
+
+	if total != 2 || len(res) != 2 {
+		t.Fatalf("ListInboundRules: wrong number of rules (%d, %d)", total, len(res))
+	}
+
+	if res[1].Rule != "badsender.com" {
+		t.Fatalf("ListInboundRules: wrong rule!: %s", res[1].Rule)
+	}
+}
+
+func TestCreateInboundRule(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/triggers/inboundrules"), func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		if _, ok := body["ID"]; ok || body["Rule"] != "badsender.com" {
+			t.Errorf("CreateInboundRule: wrong payload %v", body)
+		}
+		w.Write([]byte(`{"ID": 5, "Rule": "badsender.com"}`))
+	})
+
+	res, err := client.CreateInboundRule("badsender.com")
+	if err != nil {
+		t.Fatalf("CreateInboundRule: %s", err.Error())
+	}
+
+	if res.ID != 5 {
+		t.Fatalf("CreateInboundRule: wrong ID!: %d", res.ID)
+	}
+}
+
+func TestDeleteInboundRule(t *testing.T) {
+	responseJSON := `{
+	  "ErrorCode": 0,
+	  "Message": "Rule someone@example.com removed."
+	}`
+
+	tMux.HandleFunc(pat.Delete("/triggers/inboundrules/:ruleID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	// Success
+	err := client.DeleteInboundRule(3)
+	if err != nil {
+		t.Fatalf("DeleteInboundRule: %s", err.Error())
+	}
+
+	// Failure
+	responseJSON = `{
+	  "ErrorCode": 402,
+	  "Message": "Invalid JSON"
+	}`
+
+	err = client.DeleteInboundRule(3)
+	if err == nil {
+		t.Fatalf("DeleteInboundRule should have failed")
+	}
+}
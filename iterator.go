@@ -0,0 +1,153 @@
+package postmark
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+const (
+	// maxPageSize is the largest count Postmark accepts on list endpoints
+	maxPageSize = 500
+	// maxOffset is the furthest (count + offset) Postmark lets you page into a result set
+	maxOffset = 10000
+)
+
+// ErrOffsetLimit is returned by Iterator.Err when a search has more results than Postmark lets you page through
+var ErrOffsetLimit = errors.New("postmark: search has more than 10,000 results")
+
+// pageFunc fetches a single page of a list endpoint, returning the items and the total count
+type pageFunc[T any] func(ctx context.Context, count int64, offset int64) ([]T, int64, error)
+
+// Iterator lazily walks every page of a list endpoint.
+//
+//	it := client.IterBounces(ctx, BounceSearch{})
+//	for it.Next() {
+//		bounce := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+//
+// Postmark only allows paging through the first 10,000 results of a search;
+// past them the iteration stops with ErrOffsetLimit. Narrow the search down if you need more.
+type Iterator[T any] struct {
+	// PageSize is the number of items fetched per request. Defaults to (and is capped at) 500.
+	PageSize int64
+
+	ctx     context.Context
+	fetch   pageFunc[T]
+	page    []T
+	index   int
+	offset  int64
+	total   int64
+	started bool
+	done    bool
+	current T
+	err     error
+}
+
+func newIterator[T any](ctx context.Context, fetch pageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{
+		PageSize: maxPageSize,
+		ctx:      ctx,
+		fetch:    fetch,
+	}
+}
+
+// Next advances to the next item, fetching a new page when needed.
+// It returns false when there are no more items or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.index >= len(it.page) && !it.fetchPage() {
+		return false
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+func (it *Iterator[T]) fetchPage() bool {
+	if it.started && it.offset >= it.total {
+		it.done = true
+		return false
+	}
+	if it.offset >= maxOffset {
+		// More results than Postmark will page through, so the walk is incomplete
+		it.err = ErrOffsetLimit
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	count := it.PageSize
+	if count <= 0 || count > maxPageSize {
+		count = maxPageSize
+	}
+	if it.offset+count > maxOffset {
+		count = maxOffset - it.offset
+	}
+
+	page, total, err := it.fetch(it.ctx, count, it.offset)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.started = true
+	it.total = total
+	it.page = page
+	it.index = 0
+	it.offset += int64(len(page))
+
+	if len(page) == 0 {
+		it.done = true
+		return false
+	}
+	return true
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// TotalCount returns the total number of items reported by Postmark.
+// It's zero until the first page has been fetched.
+func (it *Iterator[T]) TotalCount() int64 {
+	return it.total
+}
+
+// All returns a range function over the remaining items. If an error
+// occurs it's yielded once, with a zero value, and the iteration stops.
+//
+//	for bounce, err := range client.IterBounces(ctx, BounceSearch{}).All() {
+//		if err != nil {
+//			// ...
+//		}
+//	}
+func (it *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Value(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
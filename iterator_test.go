@@ -0,0 +1,157 @@
+package postmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestIterBounces(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		count, _ := strconv.Atoi(req.URL.Query().Get("count"))
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+
+		if req.URL.Query().Get("tag") != "Invitation" {
+			t.Errorf("IterBounces: options not passed along")
+		}
+
+		bounces := "["
+		for i := offset; i < offset+count && i < 5; i++ {
+			if i > offset {
+				bounces += ","
+			}
+			bounces += fmt.Sprintf(`{"ID": %d}`, i)
+		}
+		bounces += "]"
+		w.Write([]byte(fmt.Sprintf(`{"TotalCount": 5, "Bounces": %s}`, bounces)))
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+
+	it := c.IterBounces(context.Background(), BounceSearch{
+		Tag: "Invitation",
+	})
+	it.PageSize = 2
+
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+
+	if it.Err() != nil {
+		t.Fatalf("IterBounces: %s", it.Err().Error())
+	}
+
+	if len(ids) != 5 || ids[4] != 4 {
+		t.Fatalf("IterBounces: wrong bounces %v", ids)
+	}
+
+	if requests != 3 {
+		t.Fatalf("IterBounces: wrong request count (%d)", requests)
+	}
+}
+
+func TestIteratorOffsetLimit(t *testing.T) {
+	var lastCount int64
+	it := newIterator(context.Background(), func(ctx context.Context, count int64, offset int64) ([]int, int64, error) {
+		lastCount = count
+		return make([]int, count), 20000, nil
+	})
+	it.PageSize = 300
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+
+	if n != maxOffset {
+		t.Fatalf("Iterator: wrong item count (%d)", n)
+	}
+
+	if lastCount != 100 {
+		t.Fatalf("Iterator: last page should be trimmed to 100, got %d", lastCount)
+	}
+
+	if it.Err() != ErrOffsetLimit {
+		t.Fatalf("Iterator: truncation should be reported, got %v", it.Err())
+	}
+}
+
+func TestIteratorAllOffsetLimit(t *testing.T) {
+	for _, total := range []int64{maxOffset, 20000} {
+		it := newIterator(context.Background(), func(ctx context.Context, count int64, offset int64) ([]int, int64, error) {
+			return make([]int, count), total, nil
+		})
+
+		n := 0
+		var gotErr error
+		for _, err := range it.All() {
+			if err != nil {
+				gotErr = err
+				break
+			}
+			n++
+		}
+
+		if n != maxOffset {
+			t.Fatalf("Iterator.All: wrong item count (%d)", n)
+		}
+
+		// Only a search with more results than the limit is truncated
+		if total > maxOffset && gotErr != ErrOffsetLimit || total <= maxOffset && gotErr != nil {
+			t.Fatalf("Iterator.All: wrong error for %d results: %v", total, gotErr)
+		}
+	}
+}
+
+func TestIteratorAllStopsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	it := newIterator(context.Background(), func(ctx context.Context, count int64, offset int64) ([]int, int64, error) {
+		if offset > 0 {
+			return nil, 0, boom
+		}
+		return []int{1, 2}, 10, nil
+	})
+
+	var items []int
+	var gotErr error
+	for item, err := range it.All() {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		items = append(items, item)
+	}
+
+	if len(items) != 2 || gotErr != boom {
+		t.Fatalf("Iterator.All: wrong result %v %v", items, gotErr)
+	}
+}
+
+func TestIteratorCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]int, int64, error) {
+		return []int{1}, 10, nil
+	})
+
+	if !it.Next() {
+		t.Fatalf("Iterator: expected first item")
+	}
+	cancel()
+
+	if it.Next() {
+		t.Fatalf("Iterator: should stop after cancellation")
+	}
+
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("Iterator: expected context.Canceled, got %v", it.Err())
+	}
+}
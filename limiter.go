@@ -0,0 +1,113 @@
+package postmark
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter paces requests made by a Client. Wait blocks until a request
+// may be sent, or returns an error if ctx is done first.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// TokenBucket is a Limiter allowing Rate requests per second on average,
+// with bursts of up to Burst requests
+type TokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket builds a TokenBucket pointer that starts full.
+// rate is in requests per second and must be positive, or it panics;
+// burst is raised to 1 if lower.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if !(rate > 0) {
+		panic("postmark: non-positive rate for NewTokenBucket")
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait takes a token from the bucket, blocking until one is available
+func (bucket *TokenBucket) Wait(ctx context.Context) error {
+	bucket.mu.Lock()
+	now := time.Now()
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate)
+	bucket.last = now
+	// Reserve a token even if it goes negative, so waiters are served in order
+	bucket.tokens--
+	deficit := -bucket.tokens
+	bucket.mu.Unlock()
+
+	if deficit <= 0 {
+		return nil
+	}
+
+	err := sleepContext(ctx, time.Duration(deficit/bucket.rate*float64(time.Second)))
+	if err != nil {
+		// Hand the reservation back
+		bucket.mu.Lock()
+		bucket.tokens++
+		bucket.mu.Unlock()
+	}
+	return err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// Semaphore caps how many requests a Client has in flight at once
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// NewSemaphore builds a Semaphore pointer allowing max concurrent requests
+func NewSemaphore(max int) *Semaphore {
+	if max < 1 {
+		max = 1
+	}
+	return &Semaphore{slots: make(chan struct{}, max)}
+}
+
+// Acquire takes a slot, blocking until one is free or ctx is done
+func (sem *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case sem.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire
+func (sem *Semaphore) Release() {
+	<-sem.slots
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// limiter returns the Limiter for the given token type, if any
+func (client *Client) limiter(tokenType string) Limiter {
+	if tokenType == account_token {
+		return client.AccountLimiter
+	}
+	return client.ServerLimiter
+}
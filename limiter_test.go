@@ -0,0 +1,89 @@
+package postmark
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(100, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := bucket.Wait(ctx); err != nil {
+			t.Fatalf("TokenBucket: %s", err.Error())
+		}
+	}
+
+	// 2 from the burst, then 2 more at 100/s
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Fatalf("TokenBucket: didn't wait long enough (%s)", elapsed)
+	}
+}
+
+func TestTokenBucketCanceled(t *testing.T) {
+	bucket := NewTokenBucket(0.001, 1)
+	bucket.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	if err := bucket.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("TokenBucket: expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestTokenBucketInvalidRate(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("NewTokenBucket: rate %v should panic", rate)
+				}
+			}()
+			NewTokenBucket(rate, 1)
+		}()
+	}
+}
+
+func TestInFlight(t *testing.T) {
+	var current, peak int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		w.Write([]byte(`{"ErrorCode": 0, "Message": "OK"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+	c.InFlight = NewSemaphore(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.SendEmail(testEmail)
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Fatalf("InFlight: too many concurrent requests (%d)", peak)
+	}
+}
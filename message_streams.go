@@ -0,0 +1,219 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Message stream types
+const (
+	MessageStreamTypeTransactional = "Transactional"
+	MessageStreamTypeBroadcasts    = "Broadcasts"
+	MessageStreamTypeInbound       = "Inbound"
+)
+
+// Unsubscribe handling types
+const (
+	UnsubscribeHandlingNone     = "None"
+	UnsubscribeHandlingPostmark = "Postmark"
+	UnsubscribeHandlingCustom   = "Custom"
+)
+
+// MessageStream is a channel on a server that separates transactional, broadcast and inbound mail
+type MessageStream struct {
+	// ID: ID of the message stream, unique per server
+	ID string
+	// ServerID: ID of the server the stream belongs to
+	ServerID int64
+	// Name: Name of the message stream
+	Name string
+	// Description: Description of the message stream
+	Description string
+	// MessageStreamType: Type of the stream, one of the MessageStreamType... constants
+	MessageStreamType string
+	// CreatedAt: Timestamp of creation
+	CreatedAt time.Time
+	// UpdatedAt: Timestamp of the last update, if any
+	UpdatedAt *time.Time
+	// ArchivedAt: Timestamp the stream was archived at, if it's archived
+	ArchivedAt *time.Time
+	// ExpectedPurgeDate: When an archived stream will be deleted, along with its data
+	ExpectedPurgeDate *time.Time
+	// SubscriptionManagementConfiguration: How unsubscribes are handled
+	SubscriptionManagementConfiguration SubscriptionManagementConfiguration
+}
+
+// SubscriptionManagementConfiguration describes how a message stream handles unsubscribes
+type SubscriptionManagementConfiguration struct {
+	// UnsubscribeHandlingType: One of the UnsubscribeHandling... constants
+	UnsubscribeHandlingType string
+}
+
+// MessageStreamCreate holds the details of a new message stream
+type MessageStreamCreate struct {
+	// ID: REQUIRED ID of the message stream, unique per server
+	ID string
+	// Name: REQUIRED Name of the message stream
+	Name string
+	// MessageStreamType: REQUIRED Type of the stream, MessageStreamTypeTransactional or MessageStreamTypeBroadcasts
+	MessageStreamType string
+	// Description: Description of the message stream
+	Description string `json:",omitempty"`
+	// SubscriptionManagementConfiguration: How unsubscribes are handled
+	SubscriptionManagementConfiguration *SubscriptionManagementConfiguration `json:",omitempty"`
+}
+
+// MessageStreamEdit holds the editable details of a message stream
+type MessageStreamEdit struct {
+	// Name: Name of the message stream
+	Name string `json:",omitempty"`
+	// Description: Description of the message stream
+	Description string `json:",omitempty"`
+	// SubscriptionManagementConfiguration: How unsubscribes are handled
+	SubscriptionManagementConfiguration *SubscriptionManagementConfiguration `json:",omitempty"`
+}
+
+// MessageStreamArchive is returned when archiving a message stream
+type MessageStreamArchive struct {
+	// ID: ID of the message stream
+	ID string
+	// ServerID: ID of the server the stream belongs to
+	ServerID int64
+	// ExpectedPurgeDate: When the stream will be deleted, along with its data
+	ExpectedPurgeDate time.Time
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type messageStreamsResponse struct {
+	TotalCount     int64
+	MessageStreams []MessageStream
+}
+
+// ListMessageStreams fetches the message streams on the server
+// streamType filters by one of the MessageStreamType... constants; empty means all types
+func (client *Client) ListMessageStreams(streamType string, includeArchived bool) ([]MessageStream, error) {
+	return client.ListMessageStreamsContext(context.Background(), streamType, includeArchived)
+}
+
+// ListMessageStreamsContext is the context-aware version of ListMessageStreams.
+func (client *Client) ListMessageStreamsContext(ctx context.Context, streamType string, includeArchived bool) ([]MessageStream, error) {
+	res := messageStreamsResponse{}
+
+	if streamType == "" {
+		streamType = "All"
+	}
+
+	values := &url.Values{}
+	values.Add("MessageStreamType", streamType)
+	values.Add("IncludeArchivedStreams", strconv.FormatBool(includeArchived))
+
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("message-streams?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res.MessageStreams, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// GetMessageStream fetches a specific message stream via streamID
+func (client *Client) GetMessageStream(streamID string) (MessageStream, error) {
+	return client.GetMessageStreamContext(context.Background(), streamID)
+}
+
+// GetMessageStreamContext is the context-aware version of GetMessageStream.
+func (client *Client) GetMessageStreamContext(ctx context.Context, streamID string) (MessageStream, error) {
+	res := MessageStream{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("message-streams/%s", streamID),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// CreateMessageStream adds a new message stream to the server
+func (client *Client) CreateMessageStream(stream MessageStreamCreate) (MessageStream, error) {
+	return client.CreateMessageStreamContext(context.Background(), stream)
+}
+
+// CreateMessageStreamContext is the context-aware version of CreateMessageStream.
+func (client *Client) CreateMessageStreamContext(ctx context.Context, stream MessageStreamCreate) (MessageStream, error) {
+	res := MessageStream{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      "message-streams",
+		Payload:   stream,
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// EditMessageStream updates details for a specific message stream with streamID
+func (client *Client) EditMessageStream(streamID string, stream MessageStreamEdit) (MessageStream, error) {
+	return client.EditMessageStreamContext(context.Background(), streamID, stream)
+}
+
+// EditMessageStreamContext is the context-aware version of EditMessageStream.
+func (client *Client) EditMessageStreamContext(ctx context.Context, streamID string, stream MessageStreamEdit) (MessageStream, error) {
+	res := MessageStream{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PATCH",
+		Path:      fmt.Sprintf("message-streams/%s", streamID),
+		Payload:   stream,
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// ArchiveMessageStream archives a message stream with streamID. Archived streams
+// are deleted, along with their data, after the ExpectedPurgeDate.
+func (client *Client) ArchiveMessageStream(streamID string) (MessageStreamArchive, error) {
+	return client.ArchiveMessageStreamContext(context.Background(), streamID)
+}
+
+// ArchiveMessageStreamContext is the context-aware version of ArchiveMessageStream.
+func (client *Client) ArchiveMessageStreamContext(ctx context.Context, streamID string) (MessageStreamArchive, error) {
+	res := MessageStreamArchive{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("message-streams/%s/archive", streamID),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// UnarchiveMessageStream restores an archived message stream with streamID
+func (client *Client) UnarchiveMessageStream(streamID string) (MessageStream, error) {
+	return client.UnarchiveMessageStreamContext(context.Background(), streamID)
+}
+
+// UnarchiveMessageStreamContext is the context-aware version of UnarchiveMessageStream.
+func (client *Client) UnarchiveMessageStreamContext(ctx context.Context, streamID string) (MessageStream, error) {
+	res := MessageStream{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("message-streams/%s/unarchive", streamID),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
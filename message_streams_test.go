@@ -0,0 +1,159 @@
+package postmark
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"goji.io/pat"
+)
+
+var messageStreamJSON = `{
+  "ID": "broadcasts",
+  "ServerID": 123457,
+  "Name": "Broadcast Stream",
+  "Description": "This is my stream to send broadcast messages",
+  "MessageStreamType": "Broadcasts",
+  "CreatedAt": "2020-07-01T00:00:00-04:00",
+  "UpdatedAt": "2020-07-05T00:00:00-04:00",
+  "ArchivedAt": null,
+  "ExpectedPurgeDate": null,
+  "SubscriptionManagementConfiguration": {
+    "UnsubscribeHandlingType": "Postmark"
+  }
+}`
+
+func TestListMessageStreams(t *testing.T) {
+	responseJSON := `{
+	  "MessageStreams": [
+		{
+		  "ID": "outbound",
+		  "ServerID": 123457,
+		  "Name": "Transactional Stream",
+		  "Description": "This is my first transactional stream",
+		  "MessageStreamType": "Transactional",
+		  "CreatedAt": "2020-07-01T00:00:00-04:00",
+		  "UpdatedAt": null,
+		  "ArchivedAt": null,
+		  "ExpectedPurgeDate": null,
+		  "SubscriptionManagementConfiguration": {
+			"UnsubscribeHandlingType": "None"
+		  }
+		},
+		` + messageStreamJSON + `
+	  ],
+	  "TotalCount": 2
+	}`
+
+	tMux.HandleFunc(pat.Get("/message-streams"), func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("MessageStreamType") != "All" {
+			t.Errorf("ListMessageStreams: wrong MessageStreamType %s", req.URL.Query().Get("MessageStreamType"))
+		}
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.ListMessageStreams("", false)
+	if err != nil {
+		t.Fatalf("ListMessageStreams: %s", err.Error())
+	}
+
+	if len(res) != 2 {
+		t.Fatalf("ListMessageStreams: wrong stream count (%d)", len(res))
+	}
+
+	if res[0].UpdatedAt != nil || res[1].UpdatedAt == nil {
+		t.Fatalf("ListMessageStreams: wrong UpdatedAt")
+	}
+}
+
+func TestGetMessageStream(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/message-streams/:streamID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(messageStreamJSON))
+	})
+
+	res, err := client.GetMessageStream("broadcasts")
+	if err != nil {
+		t.Fatalf("GetMessageStream: %s", err.Error())
+	}
+
+	if res.SubscriptionManagementConfiguration.UnsubscribeHandlingType != UnsubscribeHandlingPostmark {
+		t.Fatalf("GetMessageStream: wrong UnsubscribeHandlingType!")
+	}
+}
+
+func TestCreateMessageStream(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/message-streams"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(messageStreamJSON))
+	})
+
+	res, err := client.CreateMessageStream(MessageStreamCreate{
+		ID:                "broadcasts",
+		Name:              "Broadcast Stream",
+		MessageStreamType: MessageStreamTypeBroadcasts,
+	})
+	if err != nil {
+		t.Fatalf("CreateMessageStream: %s", err.Error())
+	}
+
+	if res.MessageStreamType != MessageStreamTypeBroadcasts {
+		t.Fatalf("CreateMessageStream: wrong MessageStreamType!: %s", res.MessageStreamType)
+	}
+}
+
+func TestEditMessageStream(t *testing.T) {
+	tMux.HandleFunc(pat.Patch("/message-streams/:streamID"), func(w http.ResponseWriter, req *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		if _, ok := payload["Name"]; ok {
+			t.Errorf("EditMessageStream: empty Name should be omitted")
+		}
+		w.Write([]byte(messageStreamJSON))
+	})
+
+	res, err := client.EditMessageStream("broadcasts", MessageStreamEdit{
+		Description: "This is my stream to send broadcast messages",
+	})
+	if err != nil {
+		t.Fatalf("EditMessageStream: %s", err.Error())
+	}
+
+	if res.Description != "This is my stream to send broadcast messages" {
+		t.Fatalf("EditMessageStream: wrong Description!: %s", res.Description)
+	}
+}
+
+func TestArchiveMessageStream(t *testing.T) {
+	responseJSON := `{
+	  "ID": "broadcasts",
+	  "ServerID": 123457,
+	  "ExpectedPurgeDate": "2020-08-30T12:30:00.00-04:00"
+	}`
+
+	tMux.HandleFunc(pat.Post("/message-streams/:streamID/archive"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.ArchiveMessageStream("broadcasts")
+	if err != nil {
+		t.Fatalf("ArchiveMessageStream: %s", err.Error())
+	}
+
+	if res.ExpectedPurgeDate.IsZero() {
+		t.Fatalf("ArchiveMessageStream: missing ExpectedPurgeDate")
+	}
+}
+
+func TestUnarchiveMessageStream(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/message-streams/:streamID/unarchive"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(messageStreamJSON))
+	})
+
+	res, err := client.UnarchiveMessageStream("broadcasts")
+	if err != nil {
+		t.Fatalf("UnarchiveMessageStream: %s", err.Error())
+	}
+
+	if res.ArchivedAt != nil {
+		t.Fatalf("UnarchiveMessageStream: stream should not be archived")
+	}
+}
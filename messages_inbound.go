@@ -1,8 +1,11 @@
 package postmark
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -34,6 +37,8 @@ type InboundMessage struct {
 	MailboxHash string
 	// TextBody - Plain text email message.
 	TextBody string
+	// StrippedTextReply - The reply text only, without the quoted thread. Only set if Postmark could tell them apart.
+	StrippedTextReply string
 	// HtmlBody - HTML email message.
 	HtmlBody string
 	// Tag - Tag name
@@ -56,13 +61,115 @@ func (x InboundMessage) Time() (time.Time, error) {
 	return time.Parse(time.RFC1123Z, x.Date)
 }
 
+// Header returns the value of the first header called name (case-insensitive), or ""
+func (x InboundMessage) Header(name string) string {
+	for _, header := range x.Headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
+// HeaderMessageID returns the Message-ID header, without angle brackets.
+// Not to be confused with MessageID, which is Postmark's own ID.
+func (x InboundMessage) HeaderMessageID() string {
+	ids := parseMessageIDs(x.Header("Message-ID"))
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// InReplyTo returns the message ID from the In-Reply-To header, without angle brackets
+func (x InboundMessage) InReplyTo() string {
+	ids := parseMessageIDs(x.Header("In-Reply-To"))
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// References returns the message IDs from the References header, oldest
+// first and without angle brackets
+func (x InboundMessage) References() []string {
+	return parseMessageIDs(x.Header("References"))
+}
+
+// parseMessageIDs splits a list of <id> message IDs. IDs missing their
+// angle brackets are split on whitespace instead.
+func parseMessageIDs(value string) []string {
+	var ids []string
+	for _, field := range strings.Fields(value) {
+		for _, id := range strings.Split(field, "><") {
+			id = strings.Trim(id, "<>,")
+			if id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// MailboxHashTokens splits MailboxHash on sep, e.g. the hash of
+// support+ticket-42_urgent@example.com split on "_" is [ticket-42 urgent]
+func (x InboundMessage) MailboxHashTokens(sep string) []string {
+	if x.MailboxHash == "" {
+		return nil
+	}
+	return strings.Split(x.MailboxHash, sep)
+}
+
+// SpamCheck holds the SpamAssassin results Postmark adds to inbound messages
+type SpamCheck struct {
+	// Spam: Whether SpamAssassin flagged the message (X-Spam-Status)
+	Spam bool
+	// Score: The SpamAssassin score (X-Spam-Score)
+	Score float64
+	// Tests: The SpamAssassin tests that matched (X-Spam-Tests)
+	Tests []string
+}
+
+// SpamCheck parses the X-Spam-* headers of the message. The zero SpamCheck
+// is returned if they're missing, e.g. when spam checking is off for the server.
+func (x InboundMessage) SpamCheck() (SpamCheck, error) {
+	res := SpamCheck{}
+
+	// Either "Yes"/"No", or the long SpamAssassin form "No, score=-0.1 required=5.0 ..."
+	status := x.Header("X-Spam-Status")
+	if i := strings.IndexAny(status, ", "); i >= 0 {
+		status = status[:i]
+	}
+	res.Spam = strings.EqualFold(status, "Yes")
+
+	if score := strings.TrimSpace(x.Header("X-Spam-Score")); score != "" {
+		var err error
+		res.Score, err = strconv.ParseFloat(score, 64)
+		if err != nil {
+			return res, fmt.Errorf("postmark: bad X-Spam-Score %q", score)
+		}
+	}
+
+	for _, test := range strings.Split(x.Header("X-Spam-Tests"), ",") {
+		if test = strings.TrimSpace(test); test != "" {
+			res.Tests = append(res.Tests, test)
+		}
+	}
+	return res, nil
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
 // GetInboundMessage fetches a specific inbound message via serverID
 func (client *Client) GetInboundMessage(messageID string) (InboundMessage, error) {
+	return client.GetInboundMessageContext(context.Background(), messageID)
+}
+
+// GetInboundMessageContext is the context-aware version of GetInboundMessage.
+func (client *Client) GetInboundMessageContext(ctx context.Context, messageID string) (InboundMessage, error) {
 	res := InboundMessage{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/inbound/%s/details", messageID),
 		TokenType: server_token,
@@ -73,6 +180,57 @@ func (client *Client) GetInboundMessage(messageID string) (InboundMessage, error
 ///////////////////////////////////////
 ///////////////////////////////////////
 
+// Inbound message statuses
+const (
+	InboundStatusBlocked   = "blocked"
+	InboundStatusProcessed = "processed"
+	InboundStatusQueued    = "queued"
+	InboundStatusFailed    = "failed"
+	InboundStatusScheduled = "scheduled"
+)
+
+// InboundMessageSearch filters the messages returned by GetInboundMessages. Zero values are ignored.
+type InboundMessageSearch struct {
+	// Recipient: Filter by the user who was receiving the email
+	Recipient string
+	// FromEmail: Filter by the sender email address
+	FromEmail string
+	// Subject: Filter by email subject
+	Subject string
+	// MailboxHash: Filter by mailboxhash
+	MailboxHash string
+	// Tag: Filter by tag
+	Tag string
+	// Status: Filter by status, one of the InboundStatus... constants
+	Status string
+	// FromDate: Filter messages starting from the date specified (inclusive)
+	FromDate time.Time
+	// ToDate: Filter messages up to the date specified (inclusive)
+	ToDate time.Time
+}
+
+func (search InboundMessageSearch) values() (url.Values, error) {
+	err := checkEnum("inbound message status", search.Status,
+		InboundStatusBlocked, InboundStatusProcessed, InboundStatusQueued, InboundStatusFailed, InboundStatusScheduled)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDateRange(search.FromDate, search.ToDate); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	setString(values, "recipient", search.Recipient)
+	setString(values, "fromemail", search.FromEmail)
+	setString(values, "subject", search.Subject)
+	setString(values, "mailboxhash", search.MailboxHash)
+	setString(values, "tag", search.Tag)
+	setString(values, "status", search.Status)
+	setTime(values, "fromdate", search.FromDate)
+	setTime(values, "todate", search.ToDate)
+	return values, nil
+}
+
 type inboundMessagesResponse struct {
 	TotalCount int64
 	Messages   []InboundMessage
@@ -81,18 +239,22 @@ type inboundMessagesResponse struct {
 // GetInboundMessages fetches a list of inbound message on the server
 // It returns a InboundMessage slice, the total message count, and any error that occurred
 // http://developer.postmarkapp.com/developer-api-messages.html#inbound-message-search
-func (client *Client) GetInboundMessages(count int64, offset int64, options map[string]interface{}) ([]InboundMessage, int64, error) {
+func (client *Client) GetInboundMessages(count int64, offset int64, search InboundMessageSearch) ([]InboundMessage, int64, error) {
+	return client.GetInboundMessagesContext(context.Background(), count, offset, search)
+}
+
+// GetInboundMessagesContext is the context-aware version of GetInboundMessages.
+func (client *Client) GetInboundMessagesContext(ctx context.Context, count int64, offset int64, search InboundMessageSearch) ([]InboundMessage, int64, error) {
 	res := inboundMessagesResponse{}
 
-	values := &url.Values{}
+	values, err := search.values()
+	if err != nil {
+		return nil, 0, err
+	}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
-	}
-
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/inbound?%s", values.Encode()),
 		TokenType: server_token,
@@ -101,13 +263,25 @@ func (client *Client) GetInboundMessages(count int64, offset int64, options map[
 	return res.Messages, res.TotalCount, err
 }
 
+// IterInboundMessages returns an Iterator over every inbound message matching search
+func (client *Client) IterInboundMessages(ctx context.Context, search InboundMessageSearch) *Iterator[InboundMessage] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]InboundMessage, int64, error) {
+		return client.GetInboundMessagesContext(ctx, count, offset, search)
+	})
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
 // BypassInboundMessage - Bypass rules for a blocked inbound message
 func (client *Client) BypassInboundMessage(messageID string) error {
+	return client.BypassInboundMessageContext(context.Background(), messageID)
+}
+
+// BypassInboundMessageContext is the context-aware version of BypassInboundMessage.
+func (client *Client) BypassInboundMessageContext(ctx context.Context, messageID string) error {
 	res := APIError{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "PUT",
 		Path:      fmt.Sprintf("messages/inbound/%s/bypass", messageID),
 		TokenType: server_token,
@@ -125,8 +299,13 @@ func (client *Client) BypassInboundMessage(messageID string) error {
 
 // RetryInboundMessage - Retry a failed inbound message for processing
 func (client *Client) RetryInboundMessage(messageID string) error {
+	return client.RetryInboundMessageContext(context.Background(), messageID)
+}
+
+// RetryInboundMessageContext is the context-aware version of RetryInboundMessage.
+func (client *Client) RetryInboundMessageContext(ctx context.Context, messageID string) error {
 	res := APIError{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "PUT",
 		Path:      fmt.Sprintf("messages/inbound/%s/retry", messageID),
 		TokenType: server_token,
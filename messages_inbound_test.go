@@ -3,6 +3,7 @@ package postmark
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"goji.io/pat"
 )
@@ -142,11 +143,11 @@ func TestGetInboundMessages(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	_, total, err := client.GetInboundMessages(100, 0, map[string]interface{}{
-		"recipient": "john.doe@yahoo.com",
-		"fromdate":  "2015-02-01",
-		"todate":    "2015-03-01",
-		"status":    "blocked",
+	_, total, err := client.GetInboundMessages(100, 0, InboundMessageSearch{
+		Recipient: "john.doe@yahoo.com",
+		FromDate:  time.Date(2015, 2, 1, 0, 0, 0, 0, postmarkLocation),
+		ToDate:    time.Date(2015, 3, 1, 0, 0, 0, 0, postmarkLocation),
+		Status:    InboundStatusBlocked,
 	})
 
 	if err != nil {
@@ -213,3 +214,49 @@ func TestRetryInboundMessage(t *testing.T) {
 		t.Fatalf("RetryInboundMessage should have failed")
 	}
 }
+
+func TestInboundMessageHelpers(t *testing.T) {
+	msg := InboundMessage{
+		MailboxHash:       "ticket-42_urgent",
+		StrippedTextReply: "Sounds good!",
+		Headers: []Header{
+			{Name: "X-Spam-Status", Value: "Yes, score=5.6 required=5.0"},
+			{Name: "X-Spam-Score", Value: "5.6"},
+			{Name: "X-Spam-Tests", Value: "DKIM_SIGNED, HTML_MESSAGE"},
+			{Name: "Message-ID", Value: "<c@example.com>"},
+			{Name: "In-Reply-To", Value: "<b@example.com>"},
+			{Name: "references", Value: "<a@example.com>\r\n <b@example.com>"},
+		},
+	}
+
+	if msg.HeaderMessageID() != "c@example.com" || msg.InReplyTo() != "b@example.com" {
+		t.Fatalf("InboundMessage: wrong threading headers (%s, %s)", msg.HeaderMessageID(), msg.InReplyTo())
+	}
+
+	if refs := msg.References(); len(refs) != 2 || refs[0] != "a@example.com" || refs[1] != "b@example.com" {
+		t.Fatalf("InboundMessage: wrong References %v", refs)
+	}
+
+	if tokens := msg.MailboxHashTokens("_"); len(tokens) != 2 || tokens[0] != "ticket-42" {
+		t.Fatalf("InboundMessage: wrong MailboxHashTokens %v", tokens)
+	}
+
+	spam, err := msg.SpamCheck()
+	if err != nil {
+		t.Fatalf("SpamCheck: %s", err.Error())
+	}
+	if !spam.Spam || spam.Score != 5.6 || len(spam.Tests) != 2 || spam.Tests[1] != "HTML_MESSAGE" {
+		t.Fatalf("SpamCheck: wrong result %v", spam)
+	}
+
+	msg.Headers = []Header{{Name: "X-Spam-Score", Value: "lots"}}
+	if _, err := msg.SpamCheck(); err == nil {
+		t.Fatalf("SpamCheck: should have failed on a bad score")
+	}
+
+	attachment := Attachment{Content: "aGVsbG8="}
+	b, err := attachment.Bytes()
+	if err != nil || string(b) != "hello" {
+		t.Fatalf("Attachment: wrong Bytes %q (%v)", b, err)
+	}
+}
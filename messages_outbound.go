@@ -1,8 +1,12 @@
 package postmark
 
 import (
+	"context"
 	"fmt"
+	"net/mail"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -38,6 +42,8 @@ type OutboundMessage struct {
 	Status string
 	// MessageEvents - List of summaries (MessageEvent) of things that have happened to this message. They can be Delivered, Opened, or Bounced as shown in the type field.
 	MessageEvents []MessageEvent
+	// MessageStream - ID of the message stream the message was sent through.
+	MessageStream string
 }
 
 // Recipient represents an individual who received a message
@@ -48,26 +54,174 @@ type Recipient struct {
 	Email string
 }
 
+// Address converts the recipient for use with EmailBuilder
+func (r Recipient) Address() mail.Address {
+	return mail.Address{Name: r.Name, Address: r.Email}
+}
+
 // MessageEvent represents things that have happened to a message.
 type MessageEvent struct {
 	// Recipient is who received the message (just email address)
 	Recipient string
 	// ReceivedAt is the event timestamp
 	ReceivedAt time.Time
-	// Type of event (Delivered, Opened, or Bounced)
+	// Type of event, one of the MessageEventType... constants
 	Type string
-	// Details contain information regarding the event
+	// Details contain information regarding the event. The ...Details methods read them into typed structs.
 	// http://developer.postmarkapp.com/developer-api-messages.html#outbound-message-details
 	Details map[string]string
 }
 
+// Message event types
+const (
+	MessageEventTypeDelivered           = "Delivered"
+	MessageEventTypeOpened              = "Opened"
+	MessageEventTypeBounced             = "Bounced"
+	MessageEventTypeTransient           = "Transient"
+	MessageEventTypeLinkClicked         = "LinkClicked"
+	MessageEventTypeSubscriptionChanged = "SubscriptionChanged"
+)
+
+// DeliveredDetails - details of a Delivered message event
+type DeliveredDetails struct {
+	// DeliveryMessage - The response of the recipient's mail server
+	DeliveryMessage string
+	// DestinationServer - The recipient's mail server
+	DestinationServer string
+	// DestinationIP - IP of the recipient's mail server
+	DestinationIP string
+}
+
+// DeliveredDetails returns the details of a Delivered event. ok is false for other event types.
+func (x MessageEvent) DeliveredDetails() (details DeliveredDetails, ok bool) {
+	if x.Type != MessageEventTypeDelivered {
+		return details, false
+	}
+	return DeliveredDetails{
+		DeliveryMessage:   x.Details["DeliveryMessage"],
+		DestinationServer: x.Details["DestinationServer"],
+		DestinationIP:     x.Details["DestinationIP"],
+	}, true
+}
+
+// OpenedDetails - details of an Opened message event
+type OpenedDetails struct {
+	// Summary - Describes the client the message was opened with
+	Summary string
+}
+
+// OpenedDetails returns the details of an Opened event. ok is false for other event types.
+func (x MessageEvent) OpenedDetails() (details OpenedDetails, ok bool) {
+	if x.Type != MessageEventTypeOpened {
+		return details, false
+	}
+	return OpenedDetails{
+		Summary: x.Details["Summary"],
+	}, true
+}
+
+// BouncedDetails - details of a Bounced message event
+type BouncedDetails struct {
+	// Summary - Description of the bounce
+	Summary string
+	// BounceID - ID of the bounce, for use with GetBounce
+	BounceID int64
+}
+
+// BouncedDetails returns the details of a Bounced event. ok is false for other event types.
+func (x MessageEvent) BouncedDetails() (details BouncedDetails, ok bool) {
+	if x.Type != MessageEventTypeBounced {
+		return details, false
+	}
+	bounceID, _ := strconv.ParseInt(x.Details["BounceID"], 10, 64)
+	return BouncedDetails{
+		Summary:  x.Details["Summary"],
+		BounceID: bounceID,
+	}, true
+}
+
+// TransientDetails - details of a Transient message event, a temporary delivery failure Postmark will retry
+type TransientDetails struct {
+	// Summary - Description of the failure
+	Summary string
+	// DeliveryMessage - The response of the recipient's mail server
+	DeliveryMessage string
+	// DestinationServer - The recipient's mail server
+	DestinationServer string
+	// DestinationIP - IP of the recipient's mail server
+	DestinationIP string
+}
+
+// TransientDetails returns the details of a Transient event. ok is false for other event types.
+func (x MessageEvent) TransientDetails() (details TransientDetails, ok bool) {
+	if x.Type != MessageEventTypeTransient {
+		return details, false
+	}
+	return TransientDetails{
+		Summary:           x.Details["Summary"],
+		DeliveryMessage:   x.Details["DeliveryMessage"],
+		DestinationServer: x.Details["DestinationServer"],
+		DestinationIP:     x.Details["DestinationIP"],
+	}, true
+}
+
+// LinkClickedDetails - details of a LinkClicked message event
+type LinkClickedDetails struct {
+	// Summary - Describes the client the link was clicked with
+	Summary string
+	// Link - The link that was clicked
+	Link string
+	// ClickLocation - Whether the link was in the HTML or the Text body, one of the ClickLocation... constants
+	ClickLocation string
+}
+
+// LinkClickedDetails returns the details of a LinkClicked event. ok is false for other event types.
+func (x MessageEvent) LinkClickedDetails() (details LinkClickedDetails, ok bool) {
+	if x.Type != MessageEventTypeLinkClicked {
+		return details, false
+	}
+	return LinkClickedDetails{
+		Summary:       x.Details["Summary"],
+		Link:          x.Details["Link"],
+		ClickLocation: x.Details["ClickLocation"],
+	}, true
+}
+
+// SubscriptionChangedDetails - details of a SubscriptionChanged message event
+type SubscriptionChangedDetails struct {
+	// Origin - Who changed the subscription
+	Origin SuppressionOrigin
+	// SuppressSending - Whether sending to the recipient is now suppressed
+	SuppressSending bool
+	// SuppressionReason - Why sending was suppressed
+	SuppressionReason SuppressionReason
+}
+
+// SubscriptionChangedDetails returns the details of a SubscriptionChanged event. ok is false for other event types.
+func (x MessageEvent) SubscriptionChangedDetails() (details SubscriptionChangedDetails, ok bool) {
+	if x.Type != MessageEventTypeSubscriptionChanged {
+		return details, false
+	}
+	suppressSending, _ := strconv.ParseBool(x.Details["SuppressSending"])
+	return SubscriptionChangedDetails{
+		Origin:            SuppressionOrigin(x.Details["Origin"]),
+		SuppressSending:   suppressSending,
+		SuppressionReason: SuppressionReason(x.Details["SuppressionReason"]),
+	}, true
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
 // GetOutboundMessage fetches a specific outbound message via serverID
 func (client *Client) GetOutboundMessage(messageID string) (OutboundMessage, error) {
+	return client.GetOutboundMessageContext(context.Background(), messageID)
+}
+
+// GetOutboundMessageContext is the context-aware version of GetOutboundMessage.
+func (client *Client) GetOutboundMessageContext(ctx context.Context, messageID string) (OutboundMessage, error) {
 	res := OutboundMessage{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/outbound/%s/details", messageID),
 		TokenType: server_token,
@@ -80,8 +234,13 @@ func (client *Client) GetOutboundMessage(messageID string) (OutboundMessage, err
 
 // GetOutboundMessageDump fetches the raw source of message. If no dump is available this will return an empty string.
 func (client *Client) GetOutboundMessageDump(messageID string) (string, error) {
+	return client.GetOutboundMessageDumpContext(context.Background(), messageID)
+}
+
+// GetOutboundMessageDumpContext is the context-aware version of GetOutboundMessageDump.
+func (client *Client) GetOutboundMessageDumpContext(ctx context.Context, messageID string) (string, error) {
 	res := dumpResponse{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/outbound/%s/dump", messageID),
 		TokenType: server_token,
@@ -92,6 +251,59 @@ func (client *Client) GetOutboundMessageDump(messageID string) (string, error) {
 ///////////////////////////////////////
 ///////////////////////////////////////
 
+// Outbound message statuses
+const (
+	OutboundStatusQueued = "queued"
+	OutboundStatusSent   = "sent"
+	// OutboundStatusProcessed is an alias of OutboundStatusSent
+	OutboundStatusProcessed = "processed"
+)
+
+// OutboundMessageSearch filters the messages returned by GetOutboundMessages. Zero values are ignored.
+type OutboundMessageSearch struct {
+	// Recipient: Filter by the user who was receiving the email
+	Recipient string
+	// FromEmail: Filter by the sender email address
+	FromEmail string
+	// Tag: Filter by tag
+	Tag string
+	// Subject: Filter by email subject
+	Subject string
+	// Status: Filter by status, OutboundStatusQueued, OutboundStatusSent or OutboundStatusProcessed
+	Status string
+	// FromDate: Filter messages starting from the date specified (inclusive)
+	FromDate time.Time
+	// ToDate: Filter messages up to the date specified (inclusive)
+	ToDate time.Time
+	// Metadata: Filter by metadata key/value pairs
+	Metadata map[string]string
+	// MessageStream: Filter by message stream ID
+	MessageStream string
+}
+
+func (search OutboundMessageSearch) values() (url.Values, error) {
+	if err := checkEnum("outbound message status", search.Status, OutboundStatusQueued, OutboundStatusSent, OutboundStatusProcessed); err != nil {
+		return nil, err
+	}
+	if err := checkDateRange(search.FromDate, search.ToDate); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	setString(values, "recipient", search.Recipient)
+	setString(values, "fromemail", search.FromEmail)
+	setString(values, "tag", search.Tag)
+	setString(values, "subject", search.Subject)
+	setString(values, "status", search.Status)
+	setTime(values, "fromdate", search.FromDate)
+	setTime(values, "todate", search.ToDate)
+	setString(values, "messagestream", search.MessageStream)
+	for k, v := range search.Metadata {
+		values.Set("metadata_"+k, v)
+	}
+	return values, nil
+}
+
 type outboundMessagesResponse struct {
 	TotalCount int64
 	Messages   []OutboundMessage
@@ -101,18 +313,22 @@ type outboundMessagesResponse struct {
 // It returns a OutboundMessage slice, the total message count, and any error that occurred
 // Note: that a single open is bound to a single recipient, so if the same message was sent to two recipients and both of them opened it, that will be represented by two entries in this array.
 // Available options: http://developer.postmarkapp.com/developer-api-messages.html#outbound-message-search
-func (client *Client) GetOutboundMessages(count int64, offset int64, options map[string]interface{}) ([]OutboundMessage, int64, error) {
+func (client *Client) GetOutboundMessages(count int64, offset int64, search OutboundMessageSearch) ([]OutboundMessage, int64, error) {
+	return client.GetOutboundMessagesContext(context.Background(), count, offset, search)
+}
+
+// GetOutboundMessagesContext is the context-aware version of GetOutboundMessages.
+func (client *Client) GetOutboundMessagesContext(ctx context.Context, count int64, offset int64, search OutboundMessageSearch) ([]OutboundMessage, int64, error) {
 	res := outboundMessagesResponse{}
 
-	values := &url.Values{}
+	values, err := search.values()
+	if err != nil {
+		return nil, 0, err
+	}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
-	}
-
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/outbound?%s", values.Encode()),
 		TokenType: server_token,
@@ -120,6 +336,13 @@ func (client *Client) GetOutboundMessages(count int64, offset int64, options map
 	return res.Messages, res.TotalCount, err
 }
 
+// IterOutboundMessages returns an Iterator over every outbound message matching search
+func (client *Client) IterOutboundMessages(ctx context.Context, search OutboundMessageSearch) *Iterator[OutboundMessage] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]OutboundMessage, int64, error) {
+		return client.GetOutboundMessagesContext(ctx, count, offset, search)
+	})
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
@@ -131,16 +354,134 @@ type Open struct {
 	UserAgent string
 	// MessageID - Unique ID of the message.
 	MessageID string
-	// Client - Shows the email client (or browser) used to open the email. Name company and family are described in the parameters specification for this endpoint.
-	Client map[string]string
+	// Client - Shows the email client (or browser) used to open the email.
+	Client ClientInfo
 	// OS - Shows the operating system used to open the email.
-	OS map[string]string
+	OS OSInfo
 	// Platform - Shows what platform was used to open the email. WebMail Desktop Mobile Unknown
 	Platform string
 	// ReadSeconds - Shows the reading time in seconds
 	ReadSeconds int64
 	// Geo - Contains IP of the recipient’s machine where the email was opened and the information based on that IP - geo coordinates (Coords) and country, region, city and zip.
-	Geo map[string]string
+	Geo GeoInfo
+	// ReceivedAt - Timestamp of the open
+	ReceivedAt time.Time
+	// Recipient - Email address of the recipient who opened the email
+	Recipient string
+}
+
+// ClientInfo - the email client (or browser) used to open an email or click a link
+type ClientInfo struct {
+	// Name - Full name of the client, i.e. Chrome 34.0.1847.131
+	Name string
+	// Company - Company that makes the client, i.e. Google Inc.
+	Company string
+	// Family - Client family, i.e. Chrome
+	Family string
+}
+
+// OSInfo - the operating system used to open an email or click a link
+type OSInfo struct {
+	// Name - Full name and version of the OS, i.e. OS X 10.7 Lion
+	Name string
+	// Company - Company that makes the OS, i.e. Apple Computer, Inc.
+	Company string
+	// Family - OS family without the version, i.e. OS X
+	Family string
+}
+
+// GeoInfo - where an email was opened or a link clicked, based on the recipient's IP
+type GeoInfo struct {
+	// CountryISOCode - ISO 3166 code of the country, i.e. RS
+	CountryISOCode string
+	// Country - Country name
+	Country string
+	// RegionISOCode - ISO 3166-2 code of the region
+	RegionISOCode string
+	// Region - Region name
+	Region string
+	// City - City name
+	City string
+	// Zip - Postal code
+	Zip string
+	// Coords - Latitude and longitude separated by a comma, i.e. 45.2517,19.8369. See Coordinates.
+	Coords string
+	// IP - IP address of the recipient's machine
+	IP string
+}
+
+// Coordinates parses Coords into a latitude and a longitude
+func (geo GeoInfo) Coordinates() (float64, float64, error) {
+	lat, lon, ok := strings.Cut(geo.Coords, ",")
+	if !ok {
+		return 0, 0, fmt.Errorf("postmark: bad coordinates %q", geo.Coords)
+	}
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("postmark: bad coordinates %q", geo.Coords)
+	}
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(lon), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("postmark: bad coordinates %q", geo.Coords)
+	}
+	return latitude, longitude, nil
+}
+
+// Open platforms
+const (
+	PlatformWebMail = "WebMail"
+	PlatformDesktop = "Desktop"
+	PlatformMobile  = "Mobile"
+	PlatformUnknown = "Unknown"
+)
+
+// OpenSearch filters the opens returned by GetOutboundMessagesOpens. Zero values are ignored.
+type OpenSearch struct {
+	// Recipient: Filter by the user who was receiving the email
+	Recipient string
+	// Tag: Filter by tag
+	Tag string
+	// ClientName: Filter by client name, i.e. Outlook, Gmail
+	ClientName string
+	// ClientCompany: Filter by company, i.e. Microsoft, Apple, Google
+	ClientCompany string
+	// ClientFamily: Filter by client family, i.e. OS X, Chrome
+	ClientFamily string
+	// OSName: Filter by full OS name and specific version, i.e. OS X 10.9 Mavericks, Windows 7
+	OSName string
+	// OSFamily: Filter by kind of OS used without specific version, i.e. OS X, Windows
+	OSFamily string
+	// OSCompany: Filter by company which produced the OS, i.e. Apple Computer, Inc., Microsoft Corporation
+	OSCompany string
+	// Platform: Filter by platform, one of the Platform... constants
+	Platform string
+	// Country: Filter by country messages were opened in, i.e. Denmark, Russia
+	Country string
+	// Region: Filter by full name of region messages were opened in, i.e. Moscow, New York
+	Region string
+	// City: Filter by full name of city messages were opened in, i.e. London
+	City string
+}
+
+func (search OpenSearch) values() (url.Values, error) {
+	if err := checkEnum("platform", search.Platform, PlatformWebMail, PlatformDesktop, PlatformMobile, PlatformUnknown); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	setString(values, "recipient", search.Recipient)
+	setString(values, "tag", search.Tag)
+	setString(values, "client_name", search.ClientName)
+	setString(values, "client_company", search.ClientCompany)
+	setString(values, "client_family", search.ClientFamily)
+	setString(values, "os_name", search.OSName)
+	setString(values, "os_family", search.OSFamily)
+	setString(values, "os_company", search.OSCompany)
+	setString(values, "platform", search.Platform)
+	setString(values, "country", search.Country)
+	setString(values, "region", search.Region)
+	setString(values, "city", search.City)
+	return values, nil
 }
 
 type outboundMessageOpensResponse struct {
@@ -152,18 +493,22 @@ type outboundMessageOpensResponse struct {
 // It returns a Open slice, the total opens count, and any error that occurred
 // To get opens for a specific message, use GetOutboundMessageOpens()
 // Available options: http://developer.postmarkapp.com/developer-api-messages.html#message-opens
-func (client *Client) GetOutboundMessagesOpens(count int64, offset int64, options map[string]interface{}) ([]Open, int64, error) {
+func (client *Client) GetOutboundMessagesOpens(count int64, offset int64, search OpenSearch) ([]Open, int64, error) {
+	return client.GetOutboundMessagesOpensContext(context.Background(), count, offset, search)
+}
+
+// GetOutboundMessagesOpensContext is the context-aware version of GetOutboundMessagesOpens.
+func (client *Client) GetOutboundMessagesOpensContext(ctx context.Context, count int64, offset int64, search OpenSearch) ([]Open, int64, error) {
 	res := outboundMessageOpensResponse{}
 
-	values := &url.Values{}
+	values, err := search.values()
+	if err != nil {
+		return nil, 0, err
+	}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
-	}
-
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/outbound/opens?%s", values.Encode()),
 		TokenType: server_token,
@@ -171,22 +516,158 @@ func (client *Client) GetOutboundMessagesOpens(count int64, offset int64, option
 	return res.Opens, res.TotalCount, err
 }
 
+// IterOutboundMessagesOpens returns an Iterator over every open matching search
+func (client *Client) IterOutboundMessagesOpens(ctx context.Context, search OpenSearch) *Iterator[Open] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]Open, int64, error) {
+		return client.GetOutboundMessagesOpensContext(ctx, count, offset, search)
+	})
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
 // GetOutboundMessageOpens fetches a list of opens for a specific message
 // It returns a Open slice, the total opens count, and any error that occurred
 func (client *Client) GetOutboundMessageOpens(messageID string, count int64, offset int64) ([]Open, int64, error) {
+	return client.GetOutboundMessageOpensContext(context.Background(), messageID, count, offset)
+}
+
+// GetOutboundMessageOpensContext is the context-aware version of GetOutboundMessageOpens.
+func (client *Client) GetOutboundMessageOpensContext(ctx context.Context, messageID string, count int64, offset int64) ([]Open, int64, error) {
 	res := outboundMessageOpensResponse{}
 
 	values := &url.Values{}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("messages/outbound/opens/%s?%s", messageID, values.Encode()),
 		TokenType: server_token,
 	}, &res)
 	return res.Opens, res.TotalCount, err
 }
+
+// IterOutboundMessageOpens returns an Iterator over every open of a specific message
+func (client *Client) IterOutboundMessageOpens(ctx context.Context, messageID string) *Iterator[Open] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]Open, int64, error) {
+		return client.GetOutboundMessageOpensContext(ctx, messageID, count, offset)
+	})
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// Click represents a single click on a tracked link
+type Click struct {
+	// RecordType - Always Click
+	RecordType string
+	// ClickLocation - Whether the link was clicked in the HTML or the Text body, one of the ClickLocation... constants
+	ClickLocation string
+	// Client - Shows the email client (or browser) used to click the link
+	Client ClientInfo
+	// OS - Shows the operating system used to click the link
+	OS OSInfo
+	// Platform - Shows what platform was used to click the link, one of the Platform... constants
+	Platform string
+	// UserAgent - Full user-agent header passed by the client software to Postmark
+	UserAgent string
+	// OriginalLink - The link as it was in the email, before Postmark rewrote it for tracking
+	OriginalLink string
+	// Geo - Contains IP of the recipient's machine where the link was clicked and the information based on that IP
+	Geo GeoInfo
+	// MessageID - Unique ID of the message
+	MessageID string
+	// MessageStream - ID of the message stream the message was sent through
+	MessageStream string
+	// ReceivedAt - Timestamp of the click
+	ReceivedAt time.Time
+	// Tag - Tag of the message
+	Tag string
+	// Recipient - Email address of the recipient who clicked the link
+	Recipient string
+}
+
+// Click locations
+const (
+	ClickLocationHTML = "HTML"
+	ClickLocationText = "Text"
+)
+
+// ClickSearch filters the clicks returned by GetOutboundMessagesClicks. Zero values are ignored.
+// It takes the same filters as OpenSearch.
+type ClickSearch OpenSearch
+
+func (search ClickSearch) values() (url.Values, error) {
+	return OpenSearch(search).values()
+}
+
+type outboundMessageClicksResponse struct {
+	TotalCount int64
+	Clicks     []Click
+}
+
+// GetOutboundMessagesClicks fetches a list of clicks on the server
+// It returns a Click slice, the total clicks count, and any error that occurred
+// To get clicks for a specific message, use GetOutboundMessageClicks()
+func (client *Client) GetOutboundMessagesClicks(count int64, offset int64, search ClickSearch) ([]Click, int64, error) {
+	return client.GetOutboundMessagesClicksContext(context.Background(), count, offset, search)
+}
+
+// GetOutboundMessagesClicksContext is the context-aware version of GetOutboundMessagesClicks.
+func (client *Client) GetOutboundMessagesClicksContext(ctx context.Context, count int64, offset int64, search ClickSearch) ([]Click, int64, error) {
+	res := outboundMessageClicksResponse{}
+
+	values, err := search.values()
+	if err != nil {
+		return nil, 0, err
+	}
+	values.Add("count", fmt.Sprintf("%d", count))
+	values.Add("offset", fmt.Sprintf("%d", offset))
+
+	err = client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("messages/outbound/clicks?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res.Clicks, res.TotalCount, err
+}
+
+// IterOutboundMessagesClicks returns an Iterator over every click matching search
+func (client *Client) IterOutboundMessagesClicks(ctx context.Context, search ClickSearch) *Iterator[Click] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]Click, int64, error) {
+		return client.GetOutboundMessagesClicksContext(ctx, count, offset, search)
+	})
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// GetOutboundMessageClicks fetches a list of clicks for a specific message
+// It returns a Click slice, the total clicks count, and any error that occurred
+func (client *Client) GetOutboundMessageClicks(messageID string, count int64, offset int64) ([]Click, int64, error) {
+	return client.GetOutboundMessageClicksContext(context.Background(), messageID, count, offset)
+}
+
+// GetOutboundMessageClicksContext is the context-aware version of GetOutboundMessageClicks.
+func (client *Client) GetOutboundMessageClicksContext(ctx context.Context, messageID string, count int64, offset int64) ([]Click, int64, error) {
+	res := outboundMessageClicksResponse{}
+
+	values := &url.Values{}
+	values.Add("count", fmt.Sprintf("%d", count))
+	values.Add("offset", fmt.Sprintf("%d", offset))
+
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("messages/outbound/clicks/%s?%s", messageID, values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res.Clicks, res.TotalCount, err
+}
+
+// IterOutboundMessageClicks returns an Iterator over every click of a specific message
+func (client *Client) IterOutboundMessageClicks(ctx context.Context, messageID string) *Iterator[Click] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]Click, int64, error) {
+		return client.GetOutboundMessageClicksContext(ctx, messageID, count, offset)
+	})
+}
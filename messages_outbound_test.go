@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"goji.io/pat"
 )
@@ -75,6 +76,38 @@ func TestGetOutboundMessage(t *testing.T) {
 	if res.MessageID != "07311c54-0687-4ab9-b034-b54b5bad88ba" {
 		t.Fatalf("GetOutboundMessage: wrong MessageID (%v)", res.MessageID)
 	}
+
+	delivered, ok := res.MessageEvents[0].DeliveredDetails()
+	if !ok || delivered.DestinationIP != "173.194.74.256" {
+		t.Fatalf("GetOutboundMessage: wrong delivered details (%v)", delivered)
+	}
+
+	if _, ok := res.MessageEvents[1].DeliveredDetails(); ok {
+		t.Fatalf("GetOutboundMessage: an Opened event has no delivered details")
+	}
+
+	bounced, ok := res.MessageEvents[2].BouncedDetails()
+	if !ok || bounced.BounceID != 374814878 {
+		t.Fatalf("GetOutboundMessage: wrong bounced details (%v)", bounced)
+	}
+}
+
+func TestMessageEventDetails(t *testing.T) {
+	clicked, ok := MessageEvent{
+		Type:    MessageEventTypeLinkClicked,
+		Details: map[string]string{"Link": "https://example.com", "ClickLocation": "HTML"},
+	}.LinkClickedDetails()
+	if !ok || clicked.Link != "https://example.com" || clicked.ClickLocation != ClickLocationHTML {
+		t.Fatalf("LinkClickedDetails: wrong details (%v)", clicked)
+	}
+
+	changed, ok := MessageEvent{
+		Type:    MessageEventTypeSubscriptionChanged,
+		Details: map[string]string{"Origin": "Recipient", "SuppressSending": "True", "SuppressionReason": "SpamComplaint"},
+	}.SubscriptionChangedDetails()
+	if !ok || !changed.SuppressSending || changed.Origin != SuppressionOriginRecipient || changed.SuppressionReason != SuppressionReasonSpamComplaint {
+		t.Fatalf("SubscriptionChangedDetails: wrong details (%v)", changed)
+	}
 }
 
 func TestGetOutboundMessageDump(t *testing.T) {
@@ -124,12 +157,11 @@ func TestGetOutboundMessages(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	_, total, err := client.GetOutboundMessages(100, 0, map[string]interface{}{
-		"recipient": "john.doe@yahoo.com",
-		"tag":       "welcome",
-		"status":    "",
-		"todate":    "2015-01-12",
-		"fromdate":  "2015-01-01",
+	_, total, err := client.GetOutboundMessages(100, 0, OutboundMessageSearch{
+		Recipient: "john.doe@yahoo.com",
+		Tag:       "welcome",
+		ToDate:    time.Date(2015, 1, 12, 0, 0, 0, 0, postmarkLocation),
+		FromDate:  time.Date(2015, 1, 1, 0, 0, 0, 0, postmarkLocation),
 	})
 
 	if err != nil {
@@ -182,8 +214,8 @@ func TestGetOutboundMessagesOpens(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	_, total, err := client.GetOutboundMessagesOpens(100, 0, map[string]interface{}{
-		"recipient": "john.doe@yahoo.com",
+	_, total, err := client.GetOutboundMessagesOpens(100, 0, OpenSearch{
+		Recipient: "john.doe@yahoo.com",
 	})
 
 	if err != nil {
@@ -235,7 +267,7 @@ func TestGetOutboundMessageOpens(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	_, total, err := client.GetOutboundMessageOpens("927e56d4-dc66-4070-bbf0-1db76c2ae14b", 100, 0)
+	res, total, err := client.GetOutboundMessageOpens("927e56d4-dc66-4070-bbf0-1db76c2ae14b", 100, 0)
 
 	if err != nil {
 		t.Fatalf("GetOutboundMessageOpens: %s", err.Error())
@@ -244,4 +276,104 @@ func TestGetOutboundMessageOpens(t *testing.T) {
 	if total != 1 {
 		t.Fatalf("GetOutboundMessageOpens: wrong total (%d)", total)
 	}
+
+	open := res[0]
+	if open.Client.Family != "Chrome" || open.OS.Company != "Apple Computer, Inc." || open.Geo.Zip != "21000" {
+		t.Fatalf("GetOutboundMessageOpens: wrong client info: %v", open)
+	}
+
+	if open.Recipient != "john.doe@yahoo.com" || open.ReceivedAt.IsZero() {
+		t.Fatalf("GetOutboundMessageOpens: wrong recipient: %v", open)
+	}
+
+	lat, lon, err := open.Geo.Coordinates()
+	if err != nil || lat != 45.2517 || lon != 19.8369 {
+		t.Fatalf("GetOutboundMessageOpens: wrong coordinates %f,%f (%v)", lat, lon, err)
+	}
+}
+
+func TestGeoInfoCoordinates(t *testing.T) {
+	if _, _, err := (GeoInfo{}).Coordinates(); err == nil {
+		t.Fatalf("Coordinates: should have failed without Coords")
+	}
+
+	if _, _, err := (GeoInfo{Coords: "45.2517,east"}).Coordinates(); err == nil {
+		t.Fatalf("Coordinates: should have failed on a bad longitude")
+	}
+}
+
+var clicksJSON = `{
+  "TotalCount": 1,
+  "Clicks": [
+	{
+	  "RecordType": "Click",
+	  "ClickLocation": "HTML",
+	  "Client": {
+		"Name": "Chrome 35.0.1916.153",
+		"Company": "Google",
+		"Family": "Chrome"
+	  },
+	  "OS": {
+		"Name": "OS X 10.7 Lion",
+		"Company": "Apple Computer, Inc.",
+		"Family": "OS X 10"
+	  },
+	  "Platform": "Desktop",
+	  "UserAgent": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_7_5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.153 Safari/537.36",
+	  "OriginalLink": "https://example.com",
+	  "Geo": {
+		"CountryISOCode": "RS",
+		"Country": "Serbia",
+		"City": "Novi Sad",
+		"Coords": "45.2517,19.8369",
+		"IP": "8.8.8.8"
+	  },
+	  "MessageID": "f4830d10-9c35-4f0c-bca3-3d9b459821f8",
+	  "MessageStream": "outbound",
+	  "ReceivedAt": "2017-10-25T15:21:11.4547619-04:00",
+	  "Tag": "welcome-email",
+	  "Recipient": "john@example.com"
+	}
+  ]
+}`
+
+func TestGetOutboundMessagesClicks(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/messages/outbound/clicks"), func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("platform") != PlatformDesktop {
+			t.Errorf("GetOutboundMessagesClicks: platform not sent (%s)", req.URL.RawQuery)
+		}
+		w.Write([]byte(clicksJSON))
+	})
+
+	res, total, err := client.GetOutboundMessagesClicks(100, 0, ClickSearch{Platform: PlatformDesktop})
+	if err != nil {
+		t.Fatalf("GetOutboundMessagesClicks: %s", err.Error())
+	}
+
+	if total != 1 || len(res) != 1 {
+		t.Fatalf("GetOutboundMessagesClicks: wrong number of clicks (%d, %d)", total, len(res))
+	}
+
+	if res[0].OriginalLink != "https://example.com" || res[0].ClickLocation != ClickLocationHTML {
+		t.Fatalf("GetOutboundMessagesClicks: wrong click: %v", res[0])
+	}
+
+	if _, _, err := client.GetOutboundMessagesClicks(100, 0, ClickSearch{Platform: "Toaster"}); err == nil {
+		t.Fatalf("GetOutboundMessagesClicks: should have rejected the platform")
+	}
+}
+
+func TestGetOutboundMessageClicks(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/messages/outbound/clicks/f4830d10-9c35-4f0c-bca3-3d9b459821f8"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(clicksJSON))
+	})
+
+	res, total, err := client.GetOutboundMessageClicks("f4830d10-9c35-4f0c-bca3-3d9b459821f8", 100, 0)
+	if err != nil {
+		t.Fatalf("GetOutboundMessageClicks: %s", err.Error())
+	}
+
+	if total != 1 || res[0].Recipient != "john@example.com" {
+		t.Fatalf("GetOutboundMessageClicks: wrong clicks: %v", res)
+	}
 }
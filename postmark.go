@@ -3,6 +3,7 @@ package postmark
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,6 +24,19 @@ type Client struct {
 	AccountToken string
 	// BaseURL is the root API endpoint
 	BaseURL string
+	// Retry controls how failed requests are retried. The zero value makes a single attempt.
+	Retry RetryPolicy
+	// ServerLimiter paces requests made with the server token. Nil means no pacing.
+	ServerLimiter Limiter
+	// AccountLimiter paces requests made with the account token. Nil means no pacing.
+	AccountLimiter Limiter
+	// InFlight caps the number of concurrent requests. Nil means no cap.
+	InFlight *Semaphore
+	// ValidateSends runs Email.Validate and TemplatedEmail.Validate before sending, so malformed
+	// messages fail without a round trip. Invalid messages of a batch are left out of it.
+	ValidateSends bool
+	// BatchConcurrency is how many chunks of a large batch are sent at a time. Defaults to 1.
+	BatchConcurrency int
 }
 
 const (
@@ -54,20 +68,75 @@ func NewClient(serverToken string, accountToken string) *Client {
 	}
 }
 
-func (client *Client) doRequest(opts parameters, dst interface{}) error {
+func (client *Client) doRequest(ctx context.Context, opts parameters, dst interface{}) error {
 	url := fmt.Sprintf("%s/%s", client.BaseURL, opts.Path)
 
-	req, err := http.NewRequest(opts.Method, url, nil)
+	var payloadData []byte
+	if opts.Payload != nil {
+		var err error
+		payloadData, err = json.Marshal(opts.Payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		res  *http.Response
+		body []byte
+		err  error
+	)
+	for attempt := 1; ; attempt++ {
+		res, body, err = client.send(ctx, opts, url, payloadData)
+		if ctx.Err() != nil || !client.Retry.shouldRetry(opts.Method, attempt, res, err) {
+			break
+		}
+		if err := sleepContext(ctx, client.Retry.backoff(attempt, res)); err != nil {
+			return err
+		}
+	}
 	if err != nil {
 		return err
 	}
 
-	if opts.Payload != nil {
-		payloadData, err := json.Marshal(opts.Payload)
-		if err != nil {
-			return err
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		apiErr := APIError{
+			StatusCode: res.StatusCode,
+			Path:       opts.Path,
+			Body:       body,
+		}
+		// Postmark usually describes the failure in the body, but
+		// proxies and load balancers might not
+		json.Unmarshal(body, &apiErr)
+		return apiErr
+	}
+
+	err = json.Unmarshal(body, dst)
+	return err
+}
+
+// send performs a single attempt of a request and reads the whole response body
+func (client *Client) send(ctx context.Context, opts parameters, url string, payloadData []byte) (*http.Response, []byte, error) {
+	if limiter := client.limiter(opts.TokenType); limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if client.InFlight != nil {
+		if err := client.InFlight.Acquire(ctx); err != nil {
+			return nil, nil, err
 		}
-		req.Body = ioutil.NopCloser(bytes.NewBuffer(payloadData))
+		defer client.InFlight.Release()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, opts.Method, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payloadData != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(payloadData))
+		req.ContentLength = int64(len(payloadData))
 	}
 
 	req.Header.Add("Accept", "application/json")
@@ -83,16 +152,30 @@ func (client *Client) doRequest(opts parameters, dst interface{}) error {
 
 	res, err := client.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	defer res.Body.Close()
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	err = json.Unmarshal(body, dst)
-	return err
+	return res, body, nil
+}
+
+// Bool returns a pointer to v, for setting optional fields
+func Bool(v bool) *bool {
+	return &v
+}
+
+// String returns a pointer to v, for setting optional fields
+func String(v string) *string {
+	return &v
+}
+
+// Int64 returns a pointer to v, for setting optional fields
+func Int64(v int64) *int64 {
+	return &v
 }
 
 // APIError represents errors returned by Postmark
@@ -101,9 +184,49 @@ type APIError struct {
 	ErrorCode int64
 	// Message contains error details
 	Message string
+	// StatusCode is the HTTP status code of the response, if the error came from a non-2xx response
+	StatusCode int `json:"-"`
+	// Path is the request path (relative to BaseURL) that produced the error
+	Path string `json:"-"`
+	// Body is the raw response body
+	Body []byte `json:"-"`
 }
 
+// Postmark API error codes
+// http://developer.postmarkapp.com/developer-api-overview.html#error-codes
+const (
+	// ErrCodeBadOrMissingToken - bad or missing API token
+	ErrCodeBadOrMissingToken int64 = 10
+	// ErrCodeMaintenance - Postmark is offline for maintenance
+	ErrCodeMaintenance int64 = 100
+	// ErrCodeInvalidEmailRequest - invalid email request, e.g. a malformed address
+	ErrCodeInvalidEmailRequest int64 = 300
+	// ErrCodeSenderSignatureNotFound - the From address has no sender signature
+	ErrCodeSenderSignatureNotFound int64 = 400
+	// ErrCodeSenderSignatureNotConfirmed - the From address's sender signature is not confirmed
+	ErrCodeSenderSignatureNotConfirmed int64 = 401
+	// ErrCodeInvalidJSON - the request body was not valid JSON
+	ErrCodeInvalidJSON int64 = 402
+	// ErrCodeIncompatibleJSON - the request JSON has unexpected fields
+	ErrCodeIncompatibleJSON int64 = 403
+	// ErrCodeNotAllowedToSend - the account has run out of credits
+	ErrCodeNotAllowedToSend int64 = 405
+	// ErrCodeInactiveRecipient - the recipient is marked inactive due to a bounce or spam complaint
+	ErrCodeInactiveRecipient int64 = 406
+	// ErrCodeJSONRequired - the request was not sent as JSON
+	ErrCodeJSONRequired int64 = 409
+	// ErrCodeTooManyBatchMessages - the batch contains more than 500 messages
+	ErrCodeTooManyBatchMessages int64 = 410
+	// ErrCodeForbiddenAttachmentType - an attachment has a forbidden file type
+	ErrCodeForbiddenAttachmentType int64 = 411
+	// ErrCodeTemplateNotFound - the referenced template does not exist
+	ErrCodeTemplateNotFound int64 = 1101
+)
+
 // Error returns the error message details
 func (res APIError) Error() string {
+	if res.Message == "" && res.StatusCode != 0 {
+		return fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	}
 	return res.Message
 }
@@ -1,9 +1,11 @@
 package postmark
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"testing"
 
 	"goji.io"
 )
@@ -28,3 +30,54 @@ func init() {
 	client.HTTPClient = &http.Client{Transport: transport}
 	client.BaseURL = tServer.URL
 }
+
+func TestAPIErrorFromStatusCode(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"ErrorCode": 406, "Message": "You tried to send to a recipient that has been marked as inactive."}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+
+	_, err := c.SendEmail(testEmail)
+
+	var apiErr APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("SendEmail: expected APIError, got %v", err)
+	}
+
+	if apiErr.ErrorCode != ErrCodeInactiveRecipient {
+		t.Fatalf("APIError: wrong error code (%d)", apiErr.ErrorCode)
+	}
+
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("APIError: wrong status code (%d)", apiErr.StatusCode)
+	}
+
+	if apiErr.Path != "email" {
+		t.Fatalf("APIError: wrong path (%s)", apiErr.Path)
+	}
+}
+
+func TestAPIErrorWithoutBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+
+	_, err := c.GetCurrentServer()
+
+	var apiErr APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetCurrentServer: expected APIError, got %v", err)
+	}
+
+	if apiErr.Error() != "500 Internal Server Error" {
+		t.Fatalf("APIError: wrong message (%s)", apiErr.Error())
+	}
+}
@@ -0,0 +1,171 @@
+package postmark
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 250 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+	// defaultMaxRetryAfter bounds how long a Retry-After header can make a request wait
+	defaultMaxRetryAfter = 2 * time.Minute
+)
+
+// SendRetryMode decides whether requests that are not GETs (sending email,
+// editing templates, etc) may be retried. Postmark has no idempotency keys,
+// so retrying a send that actually reached Postmark can deliver it twice.
+type SendRetryMode int
+
+const (
+	// SendRetryNever never retries non-GET requests. This is the default.
+	SendRetryNever SendRetryMode = iota
+	// SendRetryUnsent retries non-GET requests only when Postmark cannot
+	// have accepted them: the connection was never established, or Postmark
+	// answered 429 Too Many Requests or 503 Service Unavailable.
+	SendRetryUnsent
+	// SendRetryAlways retries non-GET requests on any transient failure.
+	// A send may be delivered more than once.
+	SendRetryAlways
+)
+
+// RetryPolicy configures automatic retries of transient failures: transport
+// errors, 429 Too Many Requests and 5xx responses
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including the first. Zero or one disables retries.
+	MaxAttempts int
+	// MinBackoff is the base delay before the first retry. Defaults to 250ms.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential backoff between attempts. Defaults to 30s.
+	// A longer Retry-After sent by Postmark is still honored, up to MaxRetryAfter.
+	MaxBackoff time.Duration
+	// MaxRetryAfter caps how long a Retry-After sent by Postmark can delay the next attempt. Defaults to 2m.
+	MaxRetryAfter time.Duration
+	// Sends controls whether non-GET requests are retried. Defaults to SendRetryNever.
+	Sends SendRetryMode
+}
+
+// shouldRetry reports whether a request should be attempted again after
+// the given attempt produced res or err
+func (policy RetryPolicy) shouldRetry(method string, attempt int, res *http.Response, err error) bool {
+	if attempt >= policy.MaxAttempts {
+		return false
+	}
+
+	if method == http.MethodGet {
+		return err != nil || retryableStatus(res.StatusCode)
+	}
+
+	switch policy.Sends {
+	case SendRetryUnsent:
+		if err != nil {
+			return isDialError(err)
+		}
+		return res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable
+	case SendRetryAlways:
+		return err != nil || retryableStatus(res.StatusCode)
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the attempt following attempt,
+// using exponential backoff with full jitter, or Retry-After if it's longer
+func (policy RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	min := policy.MinBackoff
+	if min <= 0 {
+		min = defaultMinBackoff
+	}
+	max := policy.MaxBackoff
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+
+	// Double up to max, without shifting min far enough to overflow
+	ceiling := min
+	for i := 1; i < attempt && ceiling < max; i++ {
+		if ceiling > max/2 {
+			ceiling = max
+			break
+		}
+		ceiling *= 2
+	}
+	if ceiling > max {
+		ceiling = max
+	}
+	wait := time.Duration(rand.Int63n(int64(ceiling) + 1))
+
+	if res != nil {
+		retryAfter := parseRetryAfter(res.Header.Get("Retry-After"))
+		maxRetryAfter := policy.MaxRetryAfter
+		if maxRetryAfter <= 0 {
+			maxRetryAfter = defaultMaxRetryAfter
+		}
+		if retryAfter > maxRetryAfter {
+			retryAfter = maxRetryAfter
+		}
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+	}
+	return wait
+}
+
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isDialError reports whether err happened while connecting, before any
+// part of the request was written
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// parseRetryAfter handles both forms of the Retry-After header: delay-seconds and HTTP-date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		// Large enough to overflow, and to be capped by MaxRetryAfter anyway
+		if seconds > int64(math.MaxInt64/time.Second) {
+			return math.MaxInt64
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
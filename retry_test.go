@@ -0,0 +1,143 @@
+package postmark
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	ts := httptest.NewServer(handler)
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+	c.Retry = RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+	return c, ts
+}
+
+func TestRetryGet(t *testing.T) {
+	var calls int32
+	c, ts := newRetryTestClient(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"ID": 1, "Name": "Staging Testing"}`))
+	})
+	defer ts.Close()
+
+	res, err := c.GetCurrentServer()
+	if err != nil {
+		t.Fatalf("GetCurrentServer: %s", err.Error())
+	}
+
+	if res.Name != "Staging Testing" {
+		t.Fatalf("GetCurrentServer: wrong name!: %s", res.Name)
+	}
+
+	if calls != 3 {
+		t.Fatalf("GetCurrentServer: wrong attempt count (%d)", calls)
+	}
+}
+
+func TestRetryGetGivesUp(t *testing.T) {
+	var calls int32
+	c, ts := newRetryTestClient(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer ts.Close()
+
+	_, err := c.GetCurrentServer()
+	if err == nil {
+		t.Fatalf("GetCurrentServer should have failed")
+	}
+
+	if calls != 3 {
+		t.Fatalf("GetCurrentServer: wrong attempt count (%d)", calls)
+	}
+}
+
+func TestRetrySendDisabledByDefault(t *testing.T) {
+	var calls int32
+	c, ts := newRetryTestClient(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer ts.Close()
+
+	_, err := c.SendEmail(testEmail)
+	if err == nil {
+		t.Fatalf("SendEmail should have failed")
+	}
+
+	if calls != 1 {
+		t.Fatalf("SendEmail: wrong attempt count (%d)", calls)
+	}
+}
+
+func TestRetrySendUnsent(t *testing.T) {
+	var calls int32
+	c, ts := newRetryTestClient(func(w http.ResponseWriter, req *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.Write([]byte(`{"ErrorCode": 0, "Message": "OK"}`))
+		}
+	})
+	defer ts.Close()
+	c.Retry.Sends = SendRetryUnsent
+
+	_, err := c.SendEmail(testEmail)
+	if err == nil {
+		t.Fatalf("SendEmail should have failed on a 500")
+	}
+
+	if calls != 2 {
+		t.Fatalf("SendEmail: wrong attempt count (%d)", calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d := parseRetryAfter("2"); d != 2*time.Second {
+		t.Fatalf("parseRetryAfter: wrong delay (%s)", d)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if d := parseRetryAfter(date); d <= 0 || d > time.Minute {
+		t.Fatalf("parseRetryAfter: wrong delay (%s)", d)
+	}
+
+	if d := parseRetryAfter("soon"); d != 0 {
+		t.Fatalf("parseRetryAfter: wrong delay (%s)", d)
+	}
+}
+
+func TestRetryBackoffBounds(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 40, MinBackoff: 5 * time.Second}
+	for attempt := 1; attempt < policy.MaxAttempts; attempt++ {
+		if wait := policy.backoff(attempt, nil); wait < 0 || wait > defaultMaxBackoff {
+			t.Fatalf("backoff: wrong wait for attempt %d (%s)", attempt, wait)
+		}
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"86400000000000"}}}
+	if wait := policy.backoff(1, res); wait != defaultMaxRetryAfter {
+		t.Fatalf("backoff: Retry-After should be capped (%s)", wait)
+	}
+
+	policy.MaxRetryAfter = time.Second
+	res.Header.Set("Retry-After", "60")
+	if wait := policy.backoff(1, res); wait > 5*time.Second {
+		t.Fatalf("backoff: Retry-After should be capped by MaxRetryAfter (%s)", wait)
+	}
+}
@@ -0,0 +1,65 @@
+package postmark
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Postmark interprets search dates in US Eastern time
+var postmarkLocation = loadPostmarkLocation()
+
+func loadPostmarkLocation() *time.Location {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.FixedZone("EST", -5*60*60)
+	}
+	return location
+}
+
+const (
+	searchTimeLayout = "2006-01-02T15:04:05"
+	searchDateLayout = "2006-01-02"
+)
+
+// setString sets a query parameter, skipping empty values
+func setString(values url.Values, key string, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+// setTime sets a query parameter to a timestamp in Postmark's timezone, skipping zero times
+func setTime(values url.Values, key string, value time.Time) {
+	if !value.IsZero() {
+		values.Set(key, value.In(postmarkLocation).Format(searchTimeLayout))
+	}
+}
+
+// setDate sets a query parameter to the calendar date of value, skipping zero times
+func setDate(values url.Values, key string, value time.Time) {
+	if !value.IsZero() {
+		values.Set(key, value.Format(searchDateLayout))
+	}
+}
+
+// checkEnum returns an error if value is set and not one of allowed
+func checkEnum(field string, value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("postmark: invalid %s %q", field, value)
+}
+
+// checkDateRange returns an error if both dates are set and out of order
+func checkDateRange(from time.Time, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return fmt.Errorf("postmark: ToDate %s is before FromDate %s", to, from)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package postmark
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBounceSearchValues(t *testing.T) {
+	inactive := true
+	values, err := BounceSearch{
+		Type:     BounceTypeHardBounce,
+		Inactive: &inactive,
+		Tag:      "Invitation",
+		FromDate: time.Date(2014, 1, 15, 17, 0, 0, 0, time.UTC),
+	}.values()
+	if err != nil {
+		t.Fatalf("BounceSearch: %s", err.Error())
+	}
+
+	if values.Get("type") != "HardBounce" || values.Get("inactive") != "true" || values.Get("tag") != "Invitation" {
+		t.Fatalf("BounceSearch: wrong values %v", values)
+	}
+
+	// Postmark expects Eastern time
+	if values.Get("fromdate") != "2014-01-15T12:00:00" {
+		t.Fatalf("BounceSearch: wrong fromdate %s", values.Get("fromdate"))
+	}
+
+	if _, ok := values["todate"]; ok {
+		t.Fatalf("BounceSearch: zero todate should be omitted")
+	}
+}
+
+func TestSearchValidation(t *testing.T) {
+	_, _, err := client.GetBounces(10, 0, BounceSearch{Type: "hardbounce"})
+	if err == nil {
+		t.Fatalf("GetBounces should have rejected the bounce type")
+	}
+
+	_, _, err = client.GetInboundMessages(10, 0, InboundMessageSearch{Status: "sent"})
+	if err == nil {
+		t.Fatalf("GetInboundMessages should have rejected the status")
+	}
+
+	_, _, err = client.GetOutboundMessages(10, 0, OutboundMessageSearch{Status: "delivered"})
+	if err == nil {
+		t.Fatalf("GetOutboundMessages should have rejected the status")
+	}
+
+	_, err = client.GetOutboundStats(StatsFilter{
+		FromDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err == nil {
+		t.Fatalf("GetOutboundStats should have rejected the date range")
+	}
+}
+
+func TestOutboundMessageSearchStatus(t *testing.T) {
+	for _, status := range []string{OutboundStatusQueued, OutboundStatusSent, OutboundStatusProcessed} {
+		values, err := OutboundMessageSearch{Status: status}.values()
+		if err != nil {
+			t.Fatalf("OutboundMessageSearch: %s", err.Error())
+		}
+
+		if values.Get("status") != status {
+			t.Fatalf("OutboundMessageSearch: wrong status %s", values.Get("status"))
+		}
+	}
+}
+
+func TestStatsFilterValues(t *testing.T) {
+	values, err := StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+	}.values()
+	if err != nil {
+		t.Fatalf("StatsFilter: %s", err.Error())
+	}
+
+	if values.Get("fromdate") != "2014-01-01" {
+		t.Fatalf("StatsFilter: wrong fromdate %s", values.Get("fromdate"))
+	}
+}
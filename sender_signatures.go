@@ -1,11 +1,13 @@
 package postmark
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
 
 // SenderSignature contains the details of the signature of the senders
+// The DNS fields are only populated by GetSenderSignature and the editing endpoints
 type SenderSignature struct {
 	Domain              string
 	EmailAddress        string
@@ -13,6 +15,66 @@ type SenderSignature struct {
 	Name                string
 	Confirmed           bool
 	ID                  int64
+	// SPFVerified: Whether the SPF DNS record has been verified
+	SPFVerified bool
+	// SPFHost: Host name used for the SPF configuration
+	SPFHost string
+	// SPFTextValue: Value that can be optionally set up with your DNS host
+	SPFTextValue string
+	// DKIMVerified: Whether DKIM has been verified
+	DKIMVerified bool
+	// WeakDKIM: Whether DKIM is using a 1024 bit key instead of the stronger 2048 bit key
+	WeakDKIM bool
+	// DKIMHost: DNS TXT host being used to validate messages sent in
+	DKIMHost string
+	// DKIMTextValue: DNS TXT value being used to validate messages sent in
+	DKIMTextValue string
+	// DKIMPendingHost: Pending DKIM DNS TXT host, when a new key is being set up
+	DKIMPendingHost string
+	// DKIMPendingTextValue: Pending DKIM DNS TXT value, when a new key is being set up
+	DKIMPendingTextValue string
+	// DKIMRevokedHost: DKIM DNS TXT host of the key being phased out
+	DKIMRevokedHost string
+	// DKIMRevokedTextValue: DKIM DNS TXT value of the key being phased out
+	DKIMRevokedTextValue string
+	// SafeToRemoveRevokedKeyFromDNS: Whether the revoked DKIM record can be removed from DNS
+	SafeToRemoveRevokedKeyFromDNS bool
+	// DKIMUpdateStatus: Status of a DKIM renewal, Pending or Verified
+	DKIMUpdateStatus string
+	// ReturnPathDomain: The custom Return-Path domain for this signature
+	ReturnPathDomain string
+	// ReturnPathDomainVerified: Whether the Return-Path domain's CNAME record has been verified
+	ReturnPathDomainVerified bool
+	// ReturnPathDomainCNAMEValue: The CNAME DNS record value the Return-Path domain should point to
+	ReturnPathDomainCNAMEValue string
+	// ConfirmationPersonalNote: Note included in the confirmation email
+	ConfirmationPersonalNote string
+}
+
+// SenderSignatureCreate holds the details of a new sender signature
+type SenderSignatureCreate struct {
+	// FromEmail: REQUIRED The email address of the sender
+	FromEmail string
+	// Name: REQUIRED The name of the sender
+	Name string
+	// ReplyToEmail: Override for the reply-to address
+	ReplyToEmail string `json:",omitempty"`
+	// ReturnPathDomain: A custom Return-Path domain, a subdomain of the FromEmail domain with a CNAME to pm.mtasv.net
+	ReturnPathDomain string `json:",omitempty"`
+	// ConfirmationPersonalNote: Note included in the confirmation email
+	ConfirmationPersonalNote string `json:",omitempty"`
+}
+
+// SenderSignatureEdit holds the editable details of a sender signature
+type SenderSignatureEdit struct {
+	// Name: REQUIRED The name of the sender
+	Name string
+	// ReplyToEmail: Override for the reply-to address
+	ReplyToEmail string `json:",omitempty"`
+	// ReturnPathDomain: A custom Return-Path domain, a subdomain of the sender's domain with a CNAME to pm.mtasv.net
+	ReturnPathDomain string `json:",omitempty"`
+	// ConfirmationPersonalNote: Note included in the confirmation email
+	ConfirmationPersonalNote string `json:",omitempty"`
 }
 
 ///////////////////////////////////////
@@ -26,16 +88,182 @@ type SenderSignaturesList struct {
 
 // GetSenderSignatures gets a list of sender signatures, limited by count and paged by offset
 func (client *Client) GetSenderSignatures(count, offset int64) (SenderSignaturesList, error) {
+	return client.GetSenderSignaturesContext(context.Background(), count, offset)
+}
+
+// GetSenderSignaturesContext is the context-aware version of GetSenderSignatures.
+func (client *Client) GetSenderSignaturesContext(ctx context.Context, count, offset int64) (SenderSignaturesList, error) {
 	res := SenderSignaturesList{}
 
 	values := &url.Values{}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("senders?%s", values.Encode()),
-		TokenType: server_token,
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+// IterSenderSignatures returns an Iterator over every sender signature
+func (client *Client) IterSenderSignatures(ctx context.Context) *Iterator[SenderSignature] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]SenderSignature, int64, error) {
+		res, err := client.GetSenderSignaturesContext(ctx, count, offset)
+		return res.SenderSignatures, int64(res.TotalCount), err
+	})
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// GetSenderSignature fetches the full details of a sender signature with signatureID, including its DNS setup
+func (client *Client) GetSenderSignature(signatureID int64) (SenderSignature, error) {
+	return client.GetSenderSignatureContext(context.Background(), signatureID)
+}
+
+// GetSenderSignatureContext is the context-aware version of GetSenderSignature.
+func (client *Client) GetSenderSignatureContext(ctx context.Context, signatureID int64) (SenderSignature, error) {
+	res := SenderSignature{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("senders/%d", signatureID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// CreateSenderSignature adds a new sender signature. Postmark emails the address a confirmation link.
+func (client *Client) CreateSenderSignature(signature SenderSignatureCreate) (SenderSignature, error) {
+	return client.CreateSenderSignatureContext(context.Background(), signature)
+}
+
+// CreateSenderSignatureContext is the context-aware version of CreateSenderSignature.
+func (client *Client) CreateSenderSignatureContext(ctx context.Context, signature SenderSignatureCreate) (SenderSignature, error) {
+	res := SenderSignature{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      "senders",
+		Payload:   signature,
+		TokenType: account_token,
 	}, &res)
 	return res, err
 }
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// EditSenderSignature updates details for a specific sender signature with signatureID
+func (client *Client) EditSenderSignature(signatureID int64, signature SenderSignatureEdit) (SenderSignature, error) {
+	return client.EditSenderSignatureContext(context.Background(), signatureID, signature)
+}
+
+// EditSenderSignatureContext is the context-aware version of EditSenderSignature.
+func (client *Client) EditSenderSignatureContext(ctx context.Context, signatureID int64, signature SenderSignatureEdit) (SenderSignature, error) {
+	res := SenderSignature{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      fmt.Sprintf("senders/%d", signatureID),
+		Payload:   signature,
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// DeleteSenderSignature removes a sender signature (with signatureID) from the account
+func (client *Client) DeleteSenderSignature(signatureID int64) error {
+	return client.DeleteSenderSignatureContext(context.Background(), signatureID)
+}
+
+// DeleteSenderSignatureContext is the context-aware version of DeleteSenderSignature.
+func (client *Client) DeleteSenderSignatureContext(ctx context.Context, signatureID int64) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "DELETE",
+		Path:      fmt.Sprintf("senders/%d", signatureID),
+		TokenType: account_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// ResendSenderSignatureConfirmation resends the confirmation email for a sender signature with signatureID
+func (client *Client) ResendSenderSignatureConfirmation(signatureID int64) error {
+	return client.ResendSenderSignatureConfirmationContext(context.Background(), signatureID)
+}
+
+// ResendSenderSignatureConfirmationContext is the context-aware version of ResendSenderSignatureConfirmation.
+func (client *Client) ResendSenderSignatureConfirmationContext(ctx context.Context, signatureID int64) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("senders/%d/resend", signatureID),
+		TokenType: account_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// VerifySenderSignatureSPF asks Postmark to verify the SPF record of a sender signature with signatureID.
+// Check SPFVerified on the returned signature.
+// It isn't called VerifySPF, which is taken by the domain call of the same name.
+func (client *Client) VerifySenderSignatureSPF(signatureID int64) (SenderSignature, error) {
+	return client.VerifySenderSignatureSPFContext(context.Background(), signatureID)
+}
+
+// VerifySenderSignatureSPFContext is the context-aware version of VerifySenderSignatureSPF.
+func (client *Client) VerifySenderSignatureSPFContext(ctx context.Context, signatureID int64) (SenderSignature, error) {
+	res := SenderSignature{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("senders/%d/verifyspf", signatureID),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// RequestNewDKIM requests a new DKIM key for a sender signature with signatureID.
+// Until the new DNS record is verified the signature keeps using the old key.
+func (client *Client) RequestNewDKIM(signatureID int64) error {
+	return client.RequestNewDKIMContext(context.Background(), signatureID)
+}
+
+// RequestNewDKIMContext is the context-aware version of RequestNewDKIM.
+func (client *Client) RequestNewDKIMContext(ctx context.Context, signatureID int64) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      fmt.Sprintf("senders/%d/requestnewdkim", signatureID),
+		TokenType: account_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
@@ -43,3 +43,149 @@ func TestGetSenderSignatures(t *testing.T) {
 		t.Fatalf("GetSenderSignatures: wrong TotalCount!")
 	}
 }
+
+var senderSignatureJSON = `{
+  "Domain": "wildbit.com",
+  "EmailAddress": "jp@wildbit.com",
+  "ReplyToEmailAddress": "info@wildbit.com",
+  "Name": "JP Toto",
+  "Confirmed": true,
+  "SPFVerified": true,
+  "SPFHost": "wildbit.com",
+  "SPFTextValue": "v=spf1 a mx include:spf.mtasv.net ~all",
+  "DKIMVerified": false,
+  "WeakDKIM": false,
+  "DKIMHost": "",
+  "DKIMTextValue": "",
+  "DKIMPendingHost": "20131031155228.pm._domainkey.wildbit.com",
+  "DKIMPendingTextValue": "k=rsa;p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCFn...",
+  "DKIMRevokedHost": "",
+  "DKIMRevokedTextValue": "",
+  "SafeToRemoveRevokedKeyFromDNS": false,
+  "DKIMUpdateStatus": "Pending",
+  "ReturnPathDomain": "pmbounces.wildbit.com",
+  "ReturnPathDomainVerified": false,
+  "ReturnPathDomainCNAMEValue": "pm.mtasv.net",
+  "ID": 1,
+  "ConfirmationPersonalNote": "This is a note visible to the recipient to provide context of what Postmark is."
+}`
+
+func TestGetSenderSignature(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/senders/:signatureID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(senderSignatureJSON))
+	})
+
+	res, err := client.GetSenderSignature(1)
+	if err != nil {
+		t.Fatalf("GetSenderSignature: %s", err.Error())
+	}
+
+	if res.DKIMPendingHost != "20131031155228.pm._domainkey.wildbit.com" {
+		t.Fatalf("GetSenderSignature: wrong DKIMPendingHost!: %s", res.DKIMPendingHost)
+	}
+
+	if res.ReturnPathDomainCNAMEValue != "pm.mtasv.net" {
+		t.Fatalf("GetSenderSignature: wrong ReturnPathDomainCNAMEValue!: %s", res.ReturnPathDomainCNAMEValue)
+	}
+}
+
+func TestCreateSenderSignature(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/senders"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(senderSignatureJSON))
+	})
+
+	res, err := client.CreateSenderSignature(SenderSignatureCreate{
+		FromEmail:        "jp@wildbit.com",
+		Name:             "JP Toto",
+		ReturnPathDomain: "pmbounces.wildbit.com",
+	})
+	if err != nil {
+		t.Fatalf("CreateSenderSignature: %s", err.Error())
+	}
+
+	if res.ID != 1 {
+		t.Fatalf("CreateSenderSignature: wrong ID!: %d", res.ID)
+	}
+}
+
+func TestEditSenderSignature(t *testing.T) {
+	tMux.HandleFunc(pat.Put("/senders/:signatureID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(senderSignatureJSON))
+	})
+
+	res, err := client.EditSenderSignature(1, SenderSignatureEdit{
+		Name: "JP Toto",
+	})
+	if err != nil {
+		t.Fatalf("EditSenderSignature: %s", err.Error())
+	}
+
+	if res.Name != "JP Toto" {
+		t.Fatalf("EditSenderSignature: wrong name!: %s", res.Name)
+	}
+}
+
+func TestDeleteSenderSignature(t *testing.T) {
+	responseJSON := `{
+	  "ErrorCode": 0,
+	  "Message": "Signature jp@wildbit.com removed."
+	}`
+
+	tMux.HandleFunc(pat.Delete("/senders/:signatureID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	// Success
+	err := client.DeleteSenderSignature(1)
+	if err != nil {
+		t.Fatalf("DeleteSenderSignature: %s", err.Error())
+	}
+
+	// Failure
+	responseJSON = `{
+	  "ErrorCode": 402,
+	  "Message": "Invalid JSON"
+	}`
+
+	err = client.DeleteSenderSignature(1)
+	if err == nil {
+		t.Fatalf("DeleteSenderSignature should have failed")
+	}
+}
+
+func TestResendSenderSignatureConfirmation(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/senders/:signatureID/resend"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"ErrorCode": 0, "Message": "Confirmation email for Sender Signature jp@wildbit.com was re-sent."}`))
+	})
+
+	err := client.ResendSenderSignatureConfirmation(1)
+	if err != nil {
+		t.Fatalf("ResendSenderSignatureConfirmation: %s", err.Error())
+	}
+}
+
+func TestVerifySenderSignatureSPF(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/senders/:signatureID/verifyspf"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(senderSignatureJSON))
+	})
+
+	res, err := client.VerifySenderSignatureSPF(1)
+	if err != nil {
+		t.Fatalf("VerifySenderSignatureSPF: %s", err.Error())
+	}
+
+	if !res.SPFVerified {
+		t.Fatalf("VerifySenderSignatureSPF: SPF should be verified")
+	}
+}
+
+func TestRequestNewDKIM(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/senders/:signatureID/requestnewdkim"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"ErrorCode": 0, "Message": "New DKIM key requested."}`))
+	})
+
+	err := client.RequestNewDKIM(1)
+	if err != nil {
+		t.Fatalf("RequestNewDKIM: %s", err.Error())
+	}
+}
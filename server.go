@@ -1,12 +1,19 @@
 package postmark
 
+import "context"
+
 // GetCurrentServer gets details for the server associated
 // with the currently in-use server API Key
 func (client *Client) GetCurrentServer() (Server, error) {
+	return client.GetCurrentServerContext(context.Background())
+}
+
+// GetCurrentServerContext is the context-aware version of GetCurrentServer.
+func (client *Client) GetCurrentServerContext(ctx context.Context) (Server, error) {
 	res := Server{}
-	err := client.doRequest(parameters{
-		Method: "GET",
-		Path: "server",
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      "server",
 		TokenType: server_token,
 	}, &res)
 
@@ -18,11 +25,40 @@ func (client *Client) GetCurrentServer() (Server, error) {
 
 // EditCurrentServer updates details for the server associated
 // with the currently in-use server API Key
+// Every setting is sent, so zero values overwrite the current ones; use UpdateCurrentServer to change only some of them
+// Read-only fields, DeliveryType, and empty Color and TrackLinks are left out
 func (client *Client) EditCurrentServer(server Server) (Server, error) {
+	return client.EditCurrentServerContext(context.Background(), server)
+}
+
+// EditCurrentServerContext is the context-aware version of EditCurrentServer.
+func (client *Client) EditCurrentServerContext(ctx context.Context, server Server) (Server, error) {
+	res := Server{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      "server",
+		Payload:   server.editPayload(),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// UpdateCurrentServer changes only the settings set in update for the server
+// associated with the currently in-use server API Key
+func (client *Client) UpdateCurrentServer(update ServerUpdate) (Server, error) {
+	return client.UpdateCurrentServerContext(context.Background(), update)
+}
+
+// UpdateCurrentServerContext is the context-aware version of UpdateCurrentServer.
+func (client *Client) UpdateCurrentServerContext(ctx context.Context, update ServerUpdate) (Server, error) {
 	res := Server{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "PUT",
 		Path:      "server",
+		Payload:   update,
 		TokenType: server_token,
 	}, &res)
 	return res, err
@@ -1,8 +1,10 @@
 package postmark
 
 import (
+	"encoding/json"
 	"testing"
 	"net/http"
+	"net/http/httptest"
 
 	"goji.io/pat"
 )
@@ -73,6 +75,11 @@ func TestEditCurrentServer(t *testing.T) {
   "InboundSpamThreshold": 10
 }`
 	tMux.HandleFunc(pat.Put("/server"), func(w http.ResponseWriter, req *http.Request) {
+		var payload Server
+		json.NewDecoder(req.Body).Decode(&payload)
+		if payload.Name != "Production Testing" {
+			t.Errorf("EditCurrentServer: payload not sent")
+		}
 		w.Write([]byte(responseJSON))
 	})
 
@@ -88,3 +95,30 @@ func TestEditCurrentServer(t *testing.T) {
 		t.Fatalf("EditCurrentServer: wrong name!: %s", res.Name)
 	}
 }
+
+func TestUpdateCurrentServer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		if len(payload) != 1 || payload["BounceHookUrl"] != "http://hooks.example.com/bounce" {
+			t.Errorf("UpdateCurrentServer: wrong payload %v", payload)
+		}
+		w.Write([]byte(`{"ID": 1, "BounceHookUrl": "http://hooks.example.com/bounce"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+
+	res, err := c.UpdateCurrentServer(ServerUpdate{
+		BounceHookUrl: String("http://hooks.example.com/bounce"),
+	})
+
+	if err != nil {
+		t.Fatalf("UpdateCurrentServer: %s", err.Error())
+	}
+
+	if res.BounceHookUrl != "http://hooks.example.com/bounce" {
+		t.Fatalf("UpdateCurrentServer: wrong BounceHookUrl!: %s", res.BounceHookUrl)
+	}
+}
@@ -1,7 +1,9 @@
 package postmark
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 )
 
 // Server represents a server registered in your Postmark account
@@ -39,6 +41,135 @@ type Server struct {
 	InboundHash string
 	// InboundSpamThreshold is the maximum spam score for an inbound message before it's blocked.
 	InboundSpamThreshold int64
+	// DeliveryType of the server, DeliveryTypeLive or DeliveryTypeSandbox. Can only be set when creating a server.
+	DeliveryType string
+	// TrackLinks specifies link tracking for emails sent through this server, one of the TrackLinks... constants
+	TrackLinks string
+	// DeliveryHookUrl to POST to every time a delivery event occurs.
+	DeliveryHookUrl string
+	// ClickHookUrl to POST to every time a click event occurs.
+	ClickHookUrl string
+	// IncludeBounceContentInHook specifies whether the bounce webhook includes the full content of the bounced message.
+	IncludeBounceContentInHook bool
+	// EnableSmtpApiErrorHooks specifies whether SMTP API errors are included in bounce webhooks.
+	EnableSmtpApiErrorHooks bool
+}
+
+// Server delivery types
+const (
+	DeliveryTypeLive    = "Live"
+	DeliveryTypeSandbox = "Sandbox"
+)
+
+// Link tracking options
+const (
+	TrackLinksNone        = "None"
+	TrackLinksHtmlAndText = "HtmlAndText"
+	TrackLinksHtmlOnly    = "HtmlOnly"
+	TrackLinksTextOnly    = "TextOnly"
+)
+
+// ServerUpdate holds the server settings to change. Nil fields are left untouched.
+type ServerUpdate struct {
+	// Name of server
+	Name *string `json:",omitempty"`
+	// Color of the server in the rack screen. Purple Blue Turquoise Green Red Yellow Grey
+	Color *string `json:",omitempty"`
+	// SmtpApiActivated specifies whether or not SMTP is enabled on this server.
+	SmtpApiActivated *bool `json:",omitempty"`
+	// RawEmailEnabled allows raw email to be sent with inbound.
+	RawEmailEnabled *bool `json:",omitempty"`
+	// InboundHookUrl to POST to every time an inbound event occurs.
+	InboundHookUrl *string `json:",omitempty"`
+	// BounceHookUrl to POST to every time a bounce event occurs.
+	BounceHookUrl *string `json:",omitempty"`
+	// OpenHookUrl to POST to every time an open event occurs.
+	OpenHookUrl *string `json:",omitempty"`
+	// PostFirstOpenOnly - If set to true, only the first open by a particular recipient will initiate the open webhook.
+	PostFirstOpenOnly *bool `json:",omitempty"`
+	// TrackOpens indicates if all emails being sent through this server have open tracking enabled.
+	TrackOpens *bool `json:",omitempty"`
+	// InboundDomain is the inbound domain for MX setup
+	InboundDomain *string `json:",omitempty"`
+	// InboundSpamThreshold is the maximum spam score for an inbound message before it's blocked.
+	InboundSpamThreshold *int64 `json:",omitempty"`
+	// TrackLinks specifies link tracking for emails sent through this server, one of the TrackLinks... constants
+	TrackLinks *string `json:",omitempty"`
+	// DeliveryHookUrl to POST to every time a delivery event occurs.
+	DeliveryHookUrl *string `json:",omitempty"`
+	// ClickHookUrl to POST to every time a click event occurs.
+	ClickHookUrl *string `json:",omitempty"`
+	// IncludeBounceContentInHook specifies whether the bounce webhook includes the full content of the bounced message.
+	IncludeBounceContentInHook *bool `json:",omitempty"`
+	// EnableSmtpApiErrorHooks specifies whether SMTP API errors are included in bounce webhooks.
+	EnableSmtpApiErrorHooks *bool `json:",omitempty"`
+}
+
+// ServerCreate holds the settings of a new server. Nil fields get Postmark's defaults.
+type ServerCreate struct {
+	// Name: REQUIRED Name of server
+	Name string
+	// Color of the server in the rack screen. Purple Blue Turquoise Green Red Yellow Grey
+	Color *string `json:",omitempty"`
+	// DeliveryType of the server, DeliveryTypeLive or DeliveryTypeSandbox. Defaults to Live, and can't be changed later.
+	DeliveryType *string `json:",omitempty"`
+	// SmtpApiActivated specifies whether or not SMTP is enabled on this server.
+	SmtpApiActivated *bool `json:",omitempty"`
+	// RawEmailEnabled allows raw email to be sent with inbound.
+	RawEmailEnabled *bool `json:",omitempty"`
+	// InboundHookUrl to POST to every time an inbound event occurs.
+	InboundHookUrl *string `json:",omitempty"`
+	// BounceHookUrl to POST to every time a bounce event occurs.
+	BounceHookUrl *string `json:",omitempty"`
+	// OpenHookUrl to POST to every time an open event occurs.
+	OpenHookUrl *string `json:",omitempty"`
+	// PostFirstOpenOnly - If set to true, only the first open by a particular recipient will initiate the open webhook.
+	PostFirstOpenOnly *bool `json:",omitempty"`
+	// TrackOpens indicates if all emails being sent through this server have open tracking enabled.
+	TrackOpens *bool `json:",omitempty"`
+	// InboundDomain is the inbound domain for MX setup
+	InboundDomain *string `json:",omitempty"`
+	// InboundSpamThreshold is the maximum spam score for an inbound message before it's blocked.
+	InboundSpamThreshold *int64 `json:",omitempty"`
+	// TrackLinks specifies link tracking for emails sent through this server, one of the TrackLinks... constants
+	TrackLinks *string `json:",omitempty"`
+	// DeliveryHookUrl to POST to every time a delivery event occurs.
+	DeliveryHookUrl *string `json:",omitempty"`
+	// ClickHookUrl to POST to every time a click event occurs.
+	ClickHookUrl *string `json:",omitempty"`
+	// IncludeBounceContentInHook specifies whether the bounce webhook includes the full content of the bounced message.
+	IncludeBounceContentInHook *bool `json:",omitempty"`
+	// EnableSmtpApiErrorHooks specifies whether SMTP API errors are included in bounce webhooks.
+	EnableSmtpApiErrorHooks *bool `json:",omitempty"`
+}
+
+// editPayload builds the payload of EditServer and EditCurrentServer: every
+// setting of server, without the read-only and create-only fields.
+// Empty Color and TrackLinks are left out, as they're not valid values.
+func (server Server) editPayload() ServerUpdate {
+	update := ServerUpdate{
+		Name:                       &server.Name,
+		SmtpApiActivated:           &server.SmtpApiActivated,
+		RawEmailEnabled:            &server.RawEmailEnabled,
+		InboundHookUrl:             &server.InboundHookUrl,
+		BounceHookUrl:              &server.BounceHookUrl,
+		OpenHookUrl:                &server.OpenHookUrl,
+		PostFirstOpenOnly:          &server.PostFirstOpenOnly,
+		TrackOpens:                 &server.TrackOpens,
+		InboundDomain:              &server.InboundDomain,
+		InboundSpamThreshold:       &server.InboundSpamThreshold,
+		DeliveryHookUrl:            &server.DeliveryHookUrl,
+		ClickHookUrl:               &server.ClickHookUrl,
+		IncludeBounceContentInHook: &server.IncludeBounceContentInHook,
+		EnableSmtpApiErrorHooks:    &server.EnableSmtpApiErrorHooks,
+	}
+	if server.Color != "" {
+		update.Color = &server.Color
+	}
+	if server.TrackLinks != "" {
+		update.TrackLinks = &server.TrackLinks
+	}
+	return update
 }
 
 ///////////////////////////////////////
@@ -46,8 +177,13 @@ type Server struct {
 
 // GetServer fetches a specific server via serverID
 func (client *Client) GetServer(serverID string) (Server, error) {
+	return client.GetServerContext(context.Background(), serverID)
+}
+
+// GetServerContext is the context-aware version of GetServer.
+func (client *Client) GetServerContext(ctx context.Context, serverID string) (Server, error) {
 	res := Server{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("servers/%s", serverID),
 		TokenType: account_token,
@@ -59,12 +195,125 @@ func (client *Client) GetServer(serverID string) (Server, error) {
 ///////////////////////////////////////
 
 // EditServer updates details for a specific server with serverID
+// Every setting is sent, so zero values overwrite the current ones; use UpdateServer to change only some of them
+// Read-only fields, DeliveryType, and empty Color and TrackLinks are left out
 func (client *Client) EditServer(serverID string, server Server) (Server, error) {
+	return client.EditServerContext(context.Background(), serverID, server)
+}
+
+// EditServerContext is the context-aware version of EditServer.
+func (client *Client) EditServerContext(ctx context.Context, serverID string, server Server) (Server, error) {
+	res := Server{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      fmt.Sprintf("servers/%s", serverID),
+		Payload:   server.editPayload(),
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// UpdateServer changes only the settings set in update for a specific server with serverID
+func (client *Client) UpdateServer(serverID string, update ServerUpdate) (Server, error) {
+	return client.UpdateServerContext(context.Background(), serverID, update)
+}
+
+// UpdateServerContext is the context-aware version of UpdateServer.
+func (client *Client) UpdateServerContext(ctx context.Context, serverID string, update ServerUpdate) (Server, error) {
 	res := Server{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "PUT",
 		Path:      fmt.Sprintf("servers/%s", serverID),
+		Payload:   update,
 		TokenType: account_token,
 	}, &res)
 	return res, err
 }
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type serversResponse struct {
+	TotalCount int64
+	Servers    []Server
+}
+
+// ListServers fetches a list of servers in the account, optionally filtered by name
+// It returns a Server slice, the total server count, and any error that occurred
+func (client *Client) ListServers(count int64, offset int64, name string) ([]Server, int64, error) {
+	return client.ListServersContext(context.Background(), count, offset, name)
+}
+
+// ListServersContext is the context-aware version of ListServers.
+func (client *Client) ListServersContext(ctx context.Context, count int64, offset int64, name string) ([]Server, int64, error) {
+	res := serversResponse{}
+
+	values := &url.Values{}
+	values.Add("count", fmt.Sprintf("%d", count))
+	values.Add("offset", fmt.Sprintf("%d", offset))
+	if name != "" {
+		values.Add("name", name)
+	}
+
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("servers?%s", values.Encode()),
+		TokenType: account_token,
+	}, &res)
+	return res.Servers, res.TotalCount, err
+}
+
+// IterServers returns an Iterator over every server in the account, optionally filtered by name
+func (client *Client) IterServers(ctx context.Context, name string) *Iterator[Server] {
+	return newIterator(ctx, func(ctx context.Context, count int64, offset int64) ([]Server, int64, error) {
+		return client.ListServersContext(ctx, count, offset, name)
+	})
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// CreateServer adds a new server to the account
+func (client *Client) CreateServer(server ServerCreate) (Server, error) {
+	return client.CreateServerContext(context.Background(), server)
+}
+
+// CreateServerContext is the context-aware version of CreateServer.
+func (client *Client) CreateServerContext(ctx context.Context, server ServerCreate) (Server, error) {
+	res := Server{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      "servers",
+		Payload:   server,
+		TokenType: account_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// DeleteServer removes a server (with serverID) from the account
+// Deleting servers may need to be enabled for the account by Postmark support
+func (client *Client) DeleteServer(serverID string) error {
+	return client.DeleteServerContext(context.Background(), serverID)
+}
+
+// DeleteServerContext is the context-aware version of DeleteServer.
+func (client *Client) DeleteServerContext(ctx context.Context, serverID string) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "DELETE",
+		Path:      fmt.Sprintf("servers/%s", serverID),
+		TokenType: account_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
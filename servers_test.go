@@ -1,7 +1,9 @@
 package postmark
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"goji.io/pat"
@@ -66,6 +68,16 @@ func TestEditServer(t *testing.T) {
 	}`
 
 	tMux.HandleFunc(pat.Put("/servers/:serverID"), func(w http.ResponseWriter, req *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		if payload["Name"] != "Production Testing" || payload["TrackOpens"] != false {
+			t.Errorf("EditServer: payload not sent")
+		}
+		for _, field := range []string{"ID", "ApiTokens", "ServerLink", "InboundAddress", "InboundHash", "DeliveryType", "Color", "TrackLinks"} {
+			if _, ok := payload[field]; ok {
+				t.Errorf("EditServer: %s should not be sent", field)
+			}
+		}
 		w.Write([]byte(responseJSON))
 	})
 
@@ -81,3 +93,140 @@ func TestEditServer(t *testing.T) {
 		t.Fatalf("EditServer: wrong name!: %s", res.Name)
 	}
 }
+
+func TestUpdateServer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "PUT" || req.URL.Path != "/servers/1234" {
+			t.Errorf("UpdateServer: wrong request %s %s", req.Method, req.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		if len(payload) != 1 || payload["TrackOpens"] != true {
+			t.Errorf("UpdateServer: wrong payload %v", payload)
+		}
+		w.Write([]byte(`{"ID": 1234, "Name": "Production Testing", "TrackOpens": true}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+
+	res, err := c.UpdateServer("1234", ServerUpdate{
+		TrackOpens: Bool(true),
+	})
+
+	if err != nil {
+		t.Fatalf("UpdateServer: %s", err.Error())
+	}
+
+	if !res.TrackOpens {
+		t.Fatalf("UpdateServer: wrong TrackOpens!")
+	}
+}
+
+func TestListServers(t *testing.T) {
+	responseJSON := `{
+	  "TotalCount": 2,
+	  "Servers": [
+		{
+		  "ID": 1,
+		  "Name": "Production01",
+		  "Color": "red",
+		  "DeliveryType": "Live",
+		  "TrackLinks": "HtmlAndText"
+		},
+		{
+		  "ID": 2,
+		  "Name": "Production02",
+		  "Color": "green",
+		  "DeliveryType": "Sandbox",
+		  "TrackLinks": "None"
+		}
+	  ]
+	}`
+
+	tMux.HandleFunc(pat.Get("/servers"), func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("name") != "Production" {
+			t.Errorf("ListServers: name filter not sent")
+		}
+		w.Write([]byte(responseJSON))
+	})
+
+	res, total, err := client.ListServers(50, 0, "Production")
+	if err != nil {
+		t.Fatalf("ListServers: %s", err.Error())
+	}
+
+	if total != 2 || len(res) != 2 {
+		t.Fatalf("ListServers: wrong total (%d)", total)
+	}
+
+	if res[1].DeliveryType != DeliveryTypeSandbox {
+		t.Fatalf("ListServers: wrong delivery type!: %s", res[1].DeliveryType)
+	}
+}
+
+func TestCreateServer(t *testing.T) {
+	responseJSON := `{
+	  "ID": 1,
+	  "Name": "Staging Testing",
+	  "ApiTokens": [
+		"server token"
+	  ],
+	  "Color": "red",
+	  "DeliveryType": "Live",
+	  "TrackLinks": "None",
+	  "DeliveryHookUrl": "http://hooks.example.com/delivery",
+	  "ClickHookUrl": "http://hooks.example.com/click"
+	}`
+
+	tMux.HandleFunc(pat.Post("/servers"), func(w http.ResponseWriter, req *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		if len(payload) != 2 || payload["Name"] != "Staging Testing" || payload["Color"] != "red" {
+			t.Errorf("CreateServer: wrong payload %v", payload)
+		}
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.CreateServer(ServerCreate{
+		Name:  "Staging Testing",
+		Color: String("red"),
+	})
+	if err != nil {
+		t.Fatalf("CreateServer: %s", err.Error())
+	}
+
+	if res.ClickHookUrl != "http://hooks.example.com/click" {
+		t.Fatalf("CreateServer: wrong ClickHookUrl!: %s", res.ClickHookUrl)
+	}
+}
+
+func TestDeleteServer(t *testing.T) {
+	responseJSON := `{
+	  "ErrorCode": 0,
+	  "Message": "Server Production Testing removed."
+	}`
+
+	tMux.HandleFunc(pat.Delete("/servers/:serverID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	// Success
+	err := client.DeleteServer("1234")
+	if err != nil {
+		t.Fatalf("DeleteServer: %s", err.Error())
+	}
+
+	// Failure
+	responseJSON = `{
+	  "ErrorCode": 402,
+	  "Message": "Invalid JSON"
+	}`
+
+	err = client.DeleteServer("1234")
+	if err == nil {
+		t.Fatalf("DeleteServer should have failed")
+	}
+}
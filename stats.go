@@ -1,13 +1,41 @@
 package postmark
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 ///////////////////////////////////////
 ///////////////////////////////////////
 
+// StatsFilter narrows down the stats endpoints. Zero values are ignored.
+type StatsFilter struct {
+	// Tag: Filter by tag
+	Tag string
+	// FromDate: Filter stats starting from the date specified (inclusive)
+	FromDate time.Time
+	// ToDate: Filter stats up to the date specified (inclusive)
+	ToDate time.Time
+}
+
+func (filter StatsFilter) values() (url.Values, error) {
+	if err := checkDateRange(filter.FromDate, filter.ToDate); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	setString(values, "tag", filter.Tag)
+	setDate(values, "fromdate", filter.FromDate)
+	setDate(values, "todate", filter.ToDate)
+	return values, nil
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
 // OutboundStats - a brief overview of statistics for all of your outbound email.
 type OutboundStats struct {
 	// Sent - Number of sent emails
@@ -38,15 +66,20 @@ type OutboundStats struct {
 
 // GetOutboundStats - Gets a brief overview of statistics for all of your outbound email.
 // Available options: http://developer.postmarkapp.com/developer-api-stats.html#overview
-func (client *Client) GetOutboundStats(options map[string]interface{}) (OutboundStats, error) {
+func (client *Client) GetOutboundStats(filter StatsFilter) (OutboundStats, error) {
+	return client.GetOutboundStatsContext(context.Background(), filter)
+}
+
+// GetOutboundStatsContext is the context-aware version of GetOutboundStats.
+func (client *Client) GetOutboundStatsContext(ctx context.Context, filter StatsFilter) (OutboundStats, error) {
 	res := OutboundStats{}
 
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound?%s", values.Encode()),
 		TokenType: server_token,
@@ -75,14 +108,19 @@ type SendCounts struct {
 
 // GetSentCounts - Gets a total count of emails you’ve sent out.
 // Available options: http://developer.postmarkapp.com/developer-api-stats.html#sent-counts
-func (client *Client) GetSentCounts(options map[string]interface{}) (SendCounts, error) {
+func (client *Client) GetSentCounts(filter StatsFilter) (SendCounts, error) {
+	return client.GetSentCountsContext(context.Background(), filter)
+}
+
+// GetSentCountsContext is the context-aware version of GetSentCounts.
+func (client *Client) GetSentCountsContext(ctx context.Context, filter StatsFilter) (SendCounts, error) {
 	res := SendCounts{}
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound/sends?%s", values.Encode()),
 		TokenType: server_token,
@@ -123,14 +161,19 @@ type BounceCounts struct {
 
 // GetBounceCounts - Gets total counts of emails you’ve sent out that have been returned as bounced.
 // Available options: http://developer.postmarkapp.com/developer-api-stats.html#bounce-counts
-func (client *Client) GetBounceCounts(options map[string]interface{}) (BounceCounts, error) {
+func (client *Client) GetBounceCounts(filter StatsFilter) (BounceCounts, error) {
+	return client.GetBounceCountsContext(context.Background(), filter)
+}
+
+// GetBounceCountsContext is the context-aware version of GetBounceCounts.
+func (client *Client) GetBounceCountsContext(ctx context.Context, filter StatsFilter) (BounceCounts, error) {
 	res := BounceCounts{}
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound/bounces?%s", values.Encode()),
 		TokenType: server_token,
@@ -160,14 +203,19 @@ type SpamCounts struct {
 // GetSpamCounts - Gets a total count of recipients who have marked your email as spam.
 // Days that did not produce statistics won’t appear in the JSON response.
 // Available options: http://developer.postmarkapp.com/developer-api-stats.html#spam-complaints
-func (client *Client) GetSpamCounts(options map[string]interface{}) (SpamCounts, error) {
+func (client *Client) GetSpamCounts(filter StatsFilter) (SpamCounts, error) {
+	return client.GetSpamCountsContext(context.Background(), filter)
+}
+
+// GetSpamCountsContext is the context-aware version of GetSpamCounts.
+func (client *Client) GetSpamCountsContext(ctx context.Context, filter StatsFilter) (SpamCounts, error) {
 	res := SpamCounts{}
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound/spam?%s", values.Encode()),
 		TokenType: server_token,
@@ -196,14 +244,19 @@ type TrackedCounts struct {
 
 // GetTrackedCounts - Gets a total count of emails you’ve sent with open tracking enabled.
 // Available options: http://developer.postmarkapp.com/developer-api-stats.html#email-tracked-count
-func (client *Client) GetTrackedCounts(options map[string]interface{}) (TrackedCounts, error) {
+func (client *Client) GetTrackedCounts(filter StatsFilter) (TrackedCounts, error) {
+	return client.GetTrackedCountsContext(context.Background(), filter)
+}
+
+// GetTrackedCountsContext is the context-aware version of GetTrackedCounts.
+func (client *Client) GetTrackedCountsContext(ctx context.Context, filter StatsFilter) (TrackedCounts, error) {
 	res := TrackedCounts{}
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound/tracked?%s", values.Encode()),
 		TokenType: server_token,
@@ -236,14 +289,19 @@ type OpenCounts struct {
 
 // GetOpenCounts - Gets total counts of recipients who opened your emails. This is only recorded when open tracking is enabled for that email.
 // Available options: http://developer.postmarkapp.com/developer-api-stats.html#email-opens-count
-func (client *Client) GetOpenCounts(options map[string]interface{}) (OpenCounts, error) {
+func (client *Client) GetOpenCounts(filter StatsFilter) (OpenCounts, error) {
+	return client.GetOpenCountsContext(context.Background(), filter)
+}
+
+// GetOpenCountsContext is the context-aware version of GetOpenCounts.
+func (client *Client) GetOpenCountsContext(ctx context.Context, filter StatsFilter) (OpenCounts, error) {
 	res := OpenCounts{}
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound/opens?%s", values.Encode()),
 		TokenType: server_token,
@@ -290,17 +348,216 @@ type PlatformDay struct {
 }
 
 // GetPlatformCounts gets the email platform usage
-func (client *Client) GetPlatformCounts(options map[string]interface{}) (PlatformCounts, error) {
+func (client *Client) GetPlatformCounts(filter StatsFilter) (PlatformCounts, error) {
+	return client.GetPlatformCountsContext(context.Background(), filter)
+}
+
+// GetPlatformCountsContext is the context-aware version of GetPlatformCounts.
+func (client *Client) GetPlatformCountsContext(ctx context.Context, filter StatsFilter) (PlatformCounts, error) {
 	res := PlatformCounts{}
-	values := &url.Values{}
-	for k, v := range options {
-		values.Add(k, fmt.Sprintf("%v", v))
+	values, err := filter.values()
+	if err != nil {
+		return res, err
 	}
 
-	err := client.doRequest(parameters{
+	err = client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("stats/outbound/platform?%s", values.Encode()),
 		TokenType: server_token,
 	}, &res)
 	return res, err
 }
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// ClickedDay - clicked links in outbound emails sent on a specific day
+type ClickedDay struct {
+	// Date - the date in question
+	Date string
+	// Clicks - Indicates total number of clicks. This total includes recipients who clicked links multiple times.
+	Clicks int64
+	// Unique - Indicates total number of unique link clicks.
+	Unique int64
+}
+
+// ClickCounts - clicked links in outbound emails for a period
+type ClickCounts struct {
+	// Days - List of objects that each represent clicks by date.
+	Days []ClickedDay
+	// Clicks - Indicates total number of clicks. This total includes recipients who clicked links multiple times.
+	Clicks int64
+	// Unique - Indicates total number of unique link clicks.
+	Unique int64
+}
+
+// GetClickCounts - Gets total counts of unique links that were clicked. This is only recorded when link tracking is enabled for that email.
+func (client *Client) GetClickCounts(filter StatsFilter) (ClickCounts, error) {
+	return client.GetClickCountsContext(context.Background(), filter)
+}
+
+// GetClickCountsContext is the context-aware version of GetClickCounts.
+func (client *Client) GetClickCountsContext(ctx context.Context, filter StatsFilter) (ClickCounts, error) {
+	res := ClickCounts{}
+	values, err := filter.values()
+	if err != nil {
+		return res, err
+	}
+
+	err = client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("stats/outbound/clicks?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// BrowserFamilyDay - link clicks by browser family on a specific day
+type BrowserFamilyDay struct {
+	// Date - the date in question
+	Date string
+	// Families - Number of clicks by browser family, i.e. "Google Chrome"
+	Families map[string]int64
+}
+
+// UnmarshalJSON reads the browser families, which Postmark returns as keys next to Date
+func (day *BrowserFamilyDay) UnmarshalJSON(data []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if date, ok := raw["Date"]; ok {
+		if err := json.Unmarshal(date, &day.Date); err != nil {
+			return err
+		}
+		delete(raw, "Date")
+	}
+	return unmarshalCounts(raw, &day.Families)
+}
+
+// BrowserFamilyCounts - link clicks by browser family for a period
+type BrowserFamilyCounts struct {
+	// Days - List of objects that each represent clicks by browser family by date
+	Days []BrowserFamilyDay
+	// Families - Total number of clicks by browser family
+	Families map[string]int64
+}
+
+// UnmarshalJSON reads the browser families, which Postmark returns as keys next to Days
+func (counts *BrowserFamilyCounts) UnmarshalJSON(data []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if days, ok := raw["Days"]; ok {
+		if err := json.Unmarshal(days, &counts.Days); err != nil {
+			return err
+		}
+		delete(raw, "Days")
+	}
+	return unmarshalCounts(raw, &counts.Families)
+}
+
+func unmarshalCounts(raw map[string]json.RawMessage, dst *map[string]int64) error {
+	*dst = make(map[string]int64, len(raw))
+	for name, value := range raw {
+		var count int64
+		if err := json.Unmarshal(value, &count); err != nil {
+			return err
+		}
+		(*dst)[name] = count
+	}
+	return nil
+}
+
+// GetClickBrowserFamilies gets the browsers used to click links
+func (client *Client) GetClickBrowserFamilies(filter StatsFilter) (BrowserFamilyCounts, error) {
+	return client.GetClickBrowserFamiliesContext(context.Background(), filter)
+}
+
+// GetClickBrowserFamiliesContext is the context-aware version of GetClickBrowserFamilies.
+func (client *Client) GetClickBrowserFamiliesContext(ctx context.Context, filter StatsFilter) (BrowserFamilyCounts, error) {
+	res := BrowserFamilyCounts{}
+	values, err := filter.values()
+	if err != nil {
+		return res, err
+	}
+
+	err = client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("stats/outbound/clicks/browserfamilies?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// GetClickPlatformCounts gets the platforms used to click links
+func (client *Client) GetClickPlatformCounts(filter StatsFilter) (PlatformCounts, error) {
+	return client.GetClickPlatformCountsContext(context.Background(), filter)
+}
+
+// GetClickPlatformCountsContext is the context-aware version of GetClickPlatformCounts.
+func (client *Client) GetClickPlatformCountsContext(ctx context.Context, filter StatsFilter) (PlatformCounts, error) {
+	res := PlatformCounts{}
+	values, err := filter.values()
+	if err != nil {
+		return res, err
+	}
+
+	err = client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("stats/outbound/clicks/platforms?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// ClickLocationDay - link clicks by body part on a specific day
+type ClickLocationDay struct {
+	// Date - the date in question
+	Date string
+	// HTML - Number of clicks on links in the HTML body
+	HTML int64
+	// Text - Number of clicks on links in the Text body
+	Text int64
+}
+
+// ClickLocationCounts - link clicks by body part for a period
+type ClickLocationCounts struct {
+	// Days - List of objects that each represent clicks by body part by date
+	Days []ClickLocationDay
+	// HTML - Total number of clicks on links in the HTML body
+	HTML int64
+	// Text - Total number of clicks on links in the Text body
+	Text int64
+}
+
+// GetClickLocationCounts gets whether links were clicked in the HTML or the Text body
+func (client *Client) GetClickLocationCounts(filter StatsFilter) (ClickLocationCounts, error) {
+	return client.GetClickLocationCountsContext(context.Background(), filter)
+}
+
+// GetClickLocationCountsContext is the context-aware version of GetClickLocationCounts.
+func (client *Client) GetClickLocationCountsContext(ctx context.Context, filter StatsFilter) (ClickLocationCounts, error) {
+	res := ClickLocationCounts{}
+	values, err := filter.values()
+	if err != nil {
+		return res, err
+	}
+
+	err = client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("stats/outbound/clicks/location?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
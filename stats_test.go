@@ -3,6 +3,7 @@ package postmark
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"goji.io/pat"
 )
@@ -27,9 +28,9 @@ func TestGetOutboundStats(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetOutboundStats(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetOutboundStats(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -68,9 +69,9 @@ func TestGetSentCounts(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetSentCounts(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetSentCounts(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -118,9 +119,9 @@ func TestGetBounceCounts(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetBounceCounts(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetBounceCounts(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -159,9 +160,9 @@ func TestGetSpamCounts(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetSpamCounts(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetSpamCounts(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -208,9 +209,9 @@ func TestGetTrackedCounts(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetTrackedCounts(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetTrackedCounts(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -263,9 +264,9 @@ func TestGetOpenCounts(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetOpenCounts(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetOpenCounts(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -310,9 +311,9 @@ func TestGetPlatformCounts(t *testing.T) {
 		w.Write([]byte(responseJSON))
 	})
 
-	res, err := client.GetPlatformCounts(map[string]interface{}{
-		"fromdate": "2014-01-01",
-		"todate":   "2014-02-01",
+	res, err := client.GetPlatformCounts(StatsFilter{
+		FromDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC),
 	})
 
 	if err != nil {
@@ -327,3 +328,91 @@ func TestGetPlatformCounts(t *testing.T) {
 		t.Fatalf("GetPlatformCounts: wrong day Desktop count")
 	}
 }
+
+func TestGetClickCounts(t *testing.T) {
+	responseJSON := `{
+		"Days": [
+			{
+				"Date": "2014-01-01",
+				"Clicks": 44,
+				"Unique": 4
+			}
+		],
+		"Clicks": 44,
+		"Unique": 4
+	}`
+
+	tMux.HandleFunc(pat.Get("/stats/outbound/clicks"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.GetClickCounts(StatsFilter{})
+	if err != nil {
+		t.Fatalf("GetClickCounts: %v", err.Error())
+	}
+
+	if res.Clicks != 44 || res.Days[0].Unique != 4 {
+		t.Fatalf("GetClickCounts: wrong counts: %v", res)
+	}
+}
+
+func TestGetClickBrowserFamilies(t *testing.T) {
+	responseJSON := `{
+		"Days": [
+			{
+				"Date": "2014-01-01",
+				"Google Chrome": 1,
+				"Safari": 3
+			}
+		],
+		"Google Chrome": 2,
+		"Safari": 3
+	}`
+
+	tMux.HandleFunc(pat.Get("/stats/outbound/clicks/browserfamilies"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.GetClickBrowserFamilies(StatsFilter{})
+	if err != nil {
+		t.Fatalf("GetClickBrowserFamilies: %v", err.Error())
+	}
+
+	if res.Families["Google Chrome"] != 2 || len(res.Families) != 2 {
+		t.Fatalf("GetClickBrowserFamilies: wrong totals: %v", res.Families)
+	}
+
+	if res.Days[0].Date != "2014-01-01" || res.Days[0].Families["Safari"] != 3 || len(res.Days[0].Families) != 2 {
+		t.Fatalf("GetClickBrowserFamilies: wrong day: %v", res.Days[0])
+	}
+}
+
+func TestGetClickPlatformCounts(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/stats/outbound/clicks/platforms"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"Days": [{"Date": "2014-01-01", "Desktop": 1, "Mobile": 2}], "Desktop": 1, "Mobile": 2}`))
+	})
+
+	res, err := client.GetClickPlatformCounts(StatsFilter{})
+	if err != nil {
+		t.Fatalf("GetClickPlatformCounts: %v", err.Error())
+	}
+
+	if res.Mobile != 2 || res.Days[0].Desktop != 1 {
+		t.Fatalf("GetClickPlatformCounts: wrong counts: %v", res)
+	}
+}
+
+func TestGetClickLocationCounts(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/stats/outbound/clicks/location"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"Days": [{"Date": "2014-01-01", "HTML": 1, "Text": 3}], "HTML": 1, "Text": 3}`))
+	})
+
+	res, err := client.GetClickLocationCounts(StatsFilter{})
+	if err != nil {
+		t.Fatalf("GetClickLocationCounts: %v", err.Error())
+	}
+
+	if res.Text != 3 || res.Days[0].HTML != 1 {
+		t.Fatalf("GetClickLocationCounts: wrong counts: %v", res)
+	}
+}
@@ -0,0 +1,202 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// maxSuppressionsPerRequest is how many addresses Postmark accepts per suppression request
+const maxSuppressionsPerRequest = 50
+
+// SuppressionReason is why an address was suppressed
+type SuppressionReason string
+
+// Suppression reasons
+const (
+	SuppressionReasonHardBounce        SuppressionReason = "HardBounce"
+	SuppressionReasonSpamComplaint     SuppressionReason = "SpamComplaint"
+	SuppressionReasonManualSuppression SuppressionReason = "ManualSuppression"
+)
+
+// SuppressionOrigin is who suppressed an address
+type SuppressionOrigin string
+
+// Suppression origins
+const (
+	SuppressionOriginRecipient SuppressionOrigin = "Recipient"
+	SuppressionOriginCustomer  SuppressionOrigin = "Customer"
+	SuppressionOriginAdmin     SuppressionOrigin = "Admin"
+)
+
+// Suppression result statuses
+const (
+	SuppressionStatusSuppressed = "Suppressed"
+	SuppressionStatusDeleted    = "Deleted"
+	SuppressionStatusFailed     = "Failed"
+)
+
+// Suppression is an address that a message stream won't send to
+type Suppression struct {
+	// EmailAddress: The suppressed address
+	EmailAddress string
+	// SuppressionReason: Why the address was suppressed
+	SuppressionReason SuppressionReason
+	// Origin: Who suppressed the address
+	Origin SuppressionOrigin
+	// CreatedAt: Timestamp of the suppression
+	CreatedAt time.Time
+}
+
+// SuppressionResult is the outcome of suppressing or reactivating a single address
+type SuppressionResult struct {
+	// EmailAddress: The address the result is for
+	EmailAddress string
+	// Status: SuppressionStatusSuppressed, SuppressionStatusDeleted or SuppressionStatusFailed
+	Status string
+	// Message: Why the request failed for this address, if it did
+	Message string
+}
+
+// SuppressionFilter narrows down the suppressions returned by GetSuppressions. Zero values are ignored.
+type SuppressionFilter struct {
+	// SuppressionReason: Filter by reason
+	SuppressionReason SuppressionReason
+	// Origin: Filter by origin
+	Origin SuppressionOrigin
+	// FromDate: Filter suppressions starting from the date specified (inclusive)
+	FromDate time.Time
+	// ToDate: Filter suppressions up to the date specified (inclusive)
+	ToDate time.Time
+	// EmailAddress: Filter by email address
+	EmailAddress string
+}
+
+func (filter SuppressionFilter) values() (url.Values, error) {
+	err := checkEnum("suppression reason", string(filter.SuppressionReason),
+		string(SuppressionReasonHardBounce), string(SuppressionReasonSpamComplaint), string(SuppressionReasonManualSuppression))
+	if err != nil {
+		return nil, err
+	}
+	err = checkEnum("suppression origin", string(filter.Origin),
+		string(SuppressionOriginRecipient), string(SuppressionOriginCustomer), string(SuppressionOriginAdmin))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDateRange(filter.FromDate, filter.ToDate); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	setString(values, "SuppressionReason", string(filter.SuppressionReason))
+	setString(values, "Origin", string(filter.Origin))
+	setDate(values, "fromdate", filter.FromDate)
+	setDate(values, "todate", filter.ToDate)
+	setString(values, "EmailAddress", filter.EmailAddress)
+	return values, nil
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type suppressionsResponse struct {
+	Suppressions []Suppression
+}
+
+// GetSuppressions fetches the suppressed addresses of the message stream with streamID
+func (client *Client) GetSuppressions(streamID string, filter SuppressionFilter) ([]Suppression, error) {
+	return client.GetSuppressionsContext(context.Background(), streamID, filter)
+}
+
+// GetSuppressionsContext is the context-aware version of GetSuppressions.
+func (client *Client) GetSuppressionsContext(ctx context.Context, streamID string, filter SuppressionFilter) ([]Suppression, error) {
+	res := suppressionsResponse{}
+
+	values, err := filter.values()
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("message-streams/%s/suppressions/dump?%s", streamID, values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res.Suppressions, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type suppressionEntry struct {
+	EmailAddress string
+}
+
+type suppressionsRequest struct {
+	Suppressions []suppressionEntry
+}
+
+type suppressionResultsResponse struct {
+	Suppressions []SuppressionResult
+}
+
+// CreateSuppressions stops the message stream with streamID from sending to emails
+// Postmark accepts 50 addresses per request, so longer lists are sent in several requests.
+// It returns a result per address, check the Status of each one. If a request fails, the
+// results gathered so far are returned along with the error.
+func (client *Client) CreateSuppressions(streamID string, emails []string) ([]SuppressionResult, error) {
+	return client.CreateSuppressionsContext(context.Background(), streamID, emails)
+}
+
+// CreateSuppressionsContext is the context-aware version of CreateSuppressions.
+func (client *Client) CreateSuppressionsContext(ctx context.Context, streamID string, emails []string) ([]SuppressionResult, error) {
+	return client.editSuppressions(ctx, fmt.Sprintf("message-streams/%s/suppressions", streamID), emails)
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// DeleteSuppressions reactivates emails on the message stream with streamID
+// Postmark accepts 50 addresses per request, so longer lists are sent in several requests.
+// It returns a result per address, check the Status of each one. If a request fails, the
+// results gathered so far are returned along with the error.
+func (client *Client) DeleteSuppressions(streamID string, emails []string) ([]SuppressionResult, error) {
+	return client.DeleteSuppressionsContext(context.Background(), streamID, emails)
+}
+
+// DeleteSuppressionsContext is the context-aware version of DeleteSuppressions.
+func (client *Client) DeleteSuppressionsContext(ctx context.Context, streamID string, emails []string) ([]SuppressionResult, error) {
+	return client.editSuppressions(ctx, fmt.Sprintf("message-streams/%s/suppressions/delete", streamID), emails)
+}
+
+// editSuppressions posts emails to path in chunks of maxSuppressionsPerRequest
+func (client *Client) editSuppressions(ctx context.Context, path string, emails []string) ([]SuppressionResult, error) {
+	results := make([]SuppressionResult, 0, len(emails))
+
+	for start := 0; start < len(emails); start += maxSuppressionsPerRequest {
+		end := start + maxSuppressionsPerRequest
+		if end > len(emails) {
+			end = len(emails)
+		}
+
+		payload := suppressionsRequest{}
+		for _, email := range emails[start:end] {
+			payload.Suppressions = append(payload.Suppressions, suppressionEntry{EmailAddress: email})
+		}
+
+		res := suppressionResultsResponse{}
+		err := client.doRequest(ctx, parameters{
+			Method:    "POST",
+			Path:      path,
+			Payload:   payload,
+			TokenType: server_token,
+		}, &res)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, res.Suppressions...)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,131 @@
+package postmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goji.io/pat"
+)
+
+func TestGetSuppressions(t *testing.T) {
+	responseJSON := `{
+	  "Suppressions": [
+		{
+		  "EmailAddress": "address@wildbit.com",
+		  "SuppressionReason": "ManualSuppression",
+		  "Origin": "Recipient",
+		  "CreatedAt": "2019-12-10T08:58:33-05:00"
+		},
+		{
+		  "EmailAddress": "bounce.address@wildbit.com",
+		  "SuppressionReason": "HardBounce",
+		  "Origin": "Recipient",
+		  "CreatedAt": "2019-12-11T08:58:33-05:00"
+		}
+	  ]
+	}`
+
+	tMux.HandleFunc(pat.Get("/message-streams/:streamID/suppressions/dump"), func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("fromdate") != "2019-12-01" {
+			t.Errorf("GetSuppressions: wrong fromdate %s", req.URL.Query().Get("fromdate"))
+		}
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.GetSuppressions("outbound", SuppressionFilter{
+		Origin:   SuppressionOriginRecipient,
+		FromDate: time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("GetSuppressions: %s", err.Error())
+	}
+
+	if len(res) != 2 || res[1].SuppressionReason != SuppressionReasonHardBounce {
+		t.Fatalf("GetSuppressions: wrong suppressions %v", res)
+	}
+
+	_, err = client.GetSuppressions("outbound", SuppressionFilter{
+		SuppressionReason: "Bounced",
+	})
+	if err == nil {
+		t.Fatalf("GetSuppressions should have rejected the reason")
+	}
+}
+
+func TestCreateSuppressions(t *testing.T) {
+	var chunks []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/message-streams/outbound/suppressions" {
+			t.Errorf("CreateSuppressions: wrong path %s", req.URL.Path)
+		}
+
+		payload := suppressionsRequest{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		chunks = append(chunks, len(payload.Suppressions))
+
+		res := suppressionResultsResponse{}
+		for _, s := range payload.Suppressions {
+			res.Suppressions = append(res.Suppressions, SuppressionResult{
+				EmailAddress: s.EmailAddress,
+				Status:       SuppressionStatusSuppressed,
+			})
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	c := NewClient("", "")
+	c.BaseURL = ts.URL
+
+	var emails []string
+	for i := 0; i < 120; i++ {
+		emails = append(emails, fmt.Sprintf("user%d@example.com", i))
+	}
+
+	res, err := c.CreateSuppressions("outbound", emails)
+	if err != nil {
+		t.Fatalf("CreateSuppressions: %s", err.Error())
+	}
+
+	if len(chunks) != 3 || chunks[0] != 50 || chunks[2] != 20 {
+		t.Fatalf("CreateSuppressions: wrong chunks %v", chunks)
+	}
+
+	if len(res) != 120 || res[119].EmailAddress != "user119@example.com" {
+		t.Fatalf("CreateSuppressions: wrong results (%d)", len(res))
+	}
+}
+
+func TestDeleteSuppressions(t *testing.T) {
+	responseJSON := `{
+	  "Suppressions": [
+		{
+		  "EmailAddress": "good.address@wildbit.com",
+		  "Status": "Deleted",
+		  "Message": null
+		},
+		{
+		  "EmailAddress": "not.suppressed@wildbit.com",
+		  "Status": "Failed",
+		  "Message": "You do not have the required authority to change this suppression."
+		}
+	  ]
+	}`
+
+	tMux.HandleFunc(pat.Post("/message-streams/:streamID/suppressions/delete"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	res, err := client.DeleteSuppressions("outbound", []string{"good.address@wildbit.com", "not.suppressed@wildbit.com"})
+	if err != nil {
+		t.Fatalf("DeleteSuppressions: %s", err.Error())
+	}
+
+	if res[0].Status != SuppressionStatusDeleted || res[1].Status != SuppressionStatusFailed {
+		t.Fatalf("DeleteSuppressions: wrong results %v", res)
+	}
+}
@@ -1,6 +1,7 @@
 package postmark
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -38,8 +39,13 @@ type TemplateInfo struct {
 
 // GetTemplate fetches a specific template via TemplateID
 func (client *Client) GetTemplate(templateID string) (Template, error) {
+	return client.GetTemplateContext(context.Background(), templateID)
+}
+
+// GetTemplateContext is the context-aware version of GetTemplate.
+func (client *Client) GetTemplateContext(ctx context.Context, templateID string) (Template, error) {
 	res := Template{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("templates/%s", templateID),
 		TokenType: server_token,
@@ -60,13 +66,18 @@ type templatesResponse struct {
 // Note: TemplateInfo only returns a subset of template attributes, use GetTemplate(id) to
 // retrieve all template info.
 func (client *Client) GetTemplates(count int64, offset int64) ([]TemplateInfo, int64, error) {
+	return client.GetTemplatesContext(context.Background(), count, offset)
+}
+
+// GetTemplatesContext is the context-aware version of GetTemplates.
+func (client *Client) GetTemplatesContext(ctx context.Context, count int64, offset int64) ([]TemplateInfo, int64, error) {
 	res := templatesResponse{}
 
 	values := &url.Values{}
 	values.Add("count", fmt.Sprintf("%d", count))
 	values.Add("offset", fmt.Sprintf("%d", offset))
 
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "GET",
 		Path:      fmt.Sprintf("templates?%s", values.Encode()),
 		TokenType: server_token,
@@ -74,13 +85,23 @@ func (client *Client) GetTemplates(count int64, offset int64) ([]TemplateInfo, i
 	return res.Templates, res.TotalCount, err
 }
 
+// IterTemplates returns an Iterator over every template on the server
+func (client *Client) IterTemplates(ctx context.Context) *Iterator[TemplateInfo] {
+	return newIterator(ctx, client.GetTemplatesContext)
+}
+
 ///////////////////////////////////////
 ///////////////////////////////////////
 
 // CreateTemplate saves a new template to the server
 func (client *Client) CreateTemplate(template Template) (TemplateInfo, error) {
+	return client.CreateTemplateContext(context.Background(), template)
+}
+
+// CreateTemplateContext is the context-aware version of CreateTemplate.
+func (client *Client) CreateTemplateContext(ctx context.Context, template Template) (TemplateInfo, error) {
 	res := TemplateInfo{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "POST",
 		Path:      "templates",
 		Payload:   template,
@@ -94,8 +115,13 @@ func (client *Client) CreateTemplate(template Template) (TemplateInfo, error) {
 
 // EditTemplate updates details for a specific template with templateID
 func (client *Client) EditTemplate(templateID string, template Template) (TemplateInfo, error) {
+	return client.EditTemplateContext(context.Background(), templateID, template)
+}
+
+// EditTemplateContext is the context-aware version of EditTemplate.
+func (client *Client) EditTemplateContext(ctx context.Context, templateID string, template Template) (TemplateInfo, error) {
 	res := TemplateInfo{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "PUT",
 		Path:      fmt.Sprintf("templates/%s", templateID),
 		Payload:   template,
@@ -109,8 +135,13 @@ func (client *Client) EditTemplate(templateID string, template Template) (Templa
 
 // DeleteTemplate removes a template (with templateID) from the server
 func (client *Client) DeleteTemplate(templateID string) error {
+	return client.DeleteTemplateContext(context.Background(), templateID)
+}
+
+// DeleteTemplateContext is the context-aware version of DeleteTemplate.
+func (client *Client) DeleteTemplateContext(ctx context.Context, templateID string) error {
 	res := APIError{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "DELETE",
 		Path:      fmt.Sprintf("templates/%s", templateID),
 		TokenType: server_token,
@@ -160,8 +191,13 @@ type ValidationError struct {
 
 // ValidateTemplate validates the provided template/render model combination
 func (client *Client) ValidateTemplate(validateTemplateBody ValidateTemplateBody) (ValidateTemplateResponse, error) {
+	return client.ValidateTemplateContext(context.Background(), validateTemplateBody)
+}
+
+// ValidateTemplateContext is the context-aware version of ValidateTemplate.
+func (client *Client) ValidateTemplateContext(ctx context.Context, validateTemplateBody ValidateTemplateBody) (ValidateTemplateResponse, error) {
 	res := ValidateTemplateResponse{}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "POST",
 		Path:      "templates/validate",
 		Payload:   validateTemplateBody,
@@ -199,14 +235,29 @@ type TemplatedEmail struct {
 	Headers []Header `json:",omitempty"`
 	// TrackOpens: Activate open tracking for this email.
 	TrackOpens bool `json:",omitempty"`
+	// TrackLinks: Activate link tracking for this email, one of the TrackLinks... constants. Defaults to the server's setting.
+	TrackLinks string `json:",omitempty"`
 	// Attachments: List of attachments
 	Attachments []Attachment `json:",omitempty"`
+	// MessageStream: ID of the message stream to send through. Defaults to the server's transactional stream.
+	MessageStream string `json:",omitempty"`
 }
 
 // SendTemplatedEmail sends an email using a template (TemplateId)
 func (client *Client) SendTemplatedEmail(email TemplatedEmail) (EmailResponse, error) {
+	return client.SendTemplatedEmailContext(context.Background(), email)
+}
+
+// SendTemplatedEmailContext is the context-aware version of SendTemplatedEmail.
+func (client *Client) SendTemplatedEmailContext(ctx context.Context, email TemplatedEmail) (EmailResponse, error) {
 	res := EmailResponse{}
-	err := client.doRequest(parameters{
+	if client.ValidateSends {
+		if err := email.Validate(); err != nil {
+			return res, err
+		}
+	}
+
+	err := client.doRequest(ctx, parameters{
 		Method:    "POST",
 		Path:      "email/withTemplate",
 		Payload:   email,
@@ -215,13 +266,37 @@ func (client *Client) SendTemplatedEmail(email TemplatedEmail) (EmailResponse, e
 	return res, err
 }
 
-// SendTemplatedEmail sends batch email using a template (TemplateId)
+// SendTemplatedEmailBatch sends batch email using a template (TemplateId)
+// Batches over Postmark's limits (MaxBatchMessages and MaxBatchSize) are split
+// into several requests. The responses line up with emails either way.
 func (client *Client) SendTemplatedEmailBatch(emails []TemplatedEmail) ([]EmailResponse, error) {
+	return client.SendTemplatedEmailBatchContext(context.Background(), emails)
+}
+
+// SendTemplatedEmailBatchContext is the context-aware version of SendTemplatedEmailBatch.
+func (client *Client) SendTemplatedEmailBatchContext(ctx context.Context, emails []TemplatedEmail) ([]EmailResponse, error) {
+	result := sendBatch(ctx, client, emails, client.sendTemplatedEmailBatch)
+	return result.Responses(), result.batchError()
+}
+
+// SendTemplatedEmailBatchResult sends batch email using a template like SendTemplatedEmailBatch, pairing
+// each email with its response. Check the result's Err, and send FailedMessages again.
+func (client *Client) SendTemplatedEmailBatchResult(emails []TemplatedEmail) BatchResult[TemplatedEmail] {
+	return client.SendTemplatedEmailBatchResultContext(context.Background(), emails)
+}
+
+// SendTemplatedEmailBatchResultContext is the context-aware version of SendTemplatedEmailBatchResult.
+func (client *Client) SendTemplatedEmailBatchResultContext(ctx context.Context, emails []TemplatedEmail) BatchResult[TemplatedEmail] {
+	return sendBatch(ctx, client, emails, client.sendTemplatedEmailBatch)
+}
+
+// sendTemplatedEmailBatch sends a single batch request
+func (client *Client) sendTemplatedEmailBatch(ctx context.Context, emails []TemplatedEmail) ([]EmailResponse, error) {
 	res := []EmailResponse{}
 	var formatEmails map[string]interface{} = map[string]interface{}{
 		"Messages": emails,
 	}
-	err := client.doRequest(parameters{
+	err := client.doRequest(ctx, parameters{
 		Method:    "POST",
 		Path:      "email/batchWithTemplates",
 		Payload:   formatEmails,
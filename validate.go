@@ -0,0 +1,180 @@
+package postmark
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"path/filepath"
+	"strings"
+)
+
+// Postmark's sending limits
+const (
+	// MaxRecipients is the maximum number of To, Cc and Bcc recipients of a message, combined
+	MaxRecipients = 50
+	// MaxMessageSize is the maximum size of a message, including its base64 encoded attachments
+	MaxMessageSize = 10 * 1024 * 1024
+)
+
+// forbiddenExtensions are the attachment file extensions Postmark refuses
+// https://postmarkapp.com/developer/user-guide/send-email-with-api/send-with-attachments
+var forbiddenExtensions = map[string]bool{
+	".vbs": true, ".exe": true, ".bin": true, ".bat": true, ".chm": true, ".com": true,
+	".cpl": true, ".crt": true, ".hlp": true, ".hta": true, ".inf": true, ".ins": true,
+	".isp": true, ".jse": true, ".lnk": true, ".mdb": true, ".pcd": true, ".pif": true,
+	".reg": true, ".scr": true, ".sct": true, ".shs": true, ".vbe": true, ".vba": true,
+	".wsf": true, ".wsh": true, ".wsl": true, ".msc": true, ".msi": true, ".msp": true,
+	".mst": true, ".js": true, ".jar": true, ".cmd": true, ".vb": true, ".dll": true,
+	".app": true, ".application": true, ".gadget": true, ".scf": true,
+	".ps1": true, ".ps1xml": true, ".ps2": true, ".ps2xml": true, ".psc1": true, ".psc2": true,
+	".msh": true, ".msh1": true, ".msh2": true, ".mshxml": true, ".msh1xml": true, ".msh2xml": true,
+}
+
+// FieldError is a single problem Validate found with a field of a message
+type FieldError struct {
+	// Field: The field with the problem, i.e. To, or Attachments[1]
+	Field string
+	// Problem: What's wrong with the field
+	Problem string
+}
+
+// Error returns the field and its problem
+func (err FieldError) Error() string {
+	return fmt.Sprintf("postmark: %s: %s", err.Field, err.Problem)
+}
+
+// Validate checks the email against Postmark's rules before it's sent: the
+// required fields, the recipient limit, address syntax, the total size,
+// attachment types and header names. It returns every problem found, joined
+// with errors.Join; use errors.As to get at each FieldError.
+func (email Email) Validate() error {
+	v := validator{}
+	v.required("From", email.From)
+	v.address("From", email.From)
+	v.required("To", email.To)
+	v.recipients(email.To, email.Cc, email.Bcc)
+	v.addresses("ReplyTo", email.ReplyTo)
+	if email.HtmlBody == "" && email.TextBody == "" {
+		v.add("HtmlBody", "either HtmlBody or TextBody is required")
+	}
+	v.headers(email.Headers)
+	v.attachments(email.Attachments)
+	v.size(email)
+	return errors.Join(v.problems...)
+}
+
+// Validate checks the email against Postmark's rules before it's sent, like
+// Email.Validate, requiring a TemplateId or TemplateAlias instead of a body
+func (email TemplatedEmail) Validate() error {
+	v := validator{}
+	v.required("From", email.From)
+	v.address("From", email.From)
+	v.required("To", email.To)
+	v.recipients(email.To, email.Cc, email.Bcc)
+	v.addresses("ReplyTo", email.ReplyTo)
+	if email.TemplateId == 0 && email.TemplateAlias == "" {
+		v.add("TemplateId", "either TemplateId or TemplateAlias is required")
+	}
+	v.headers(email.Headers)
+	v.attachments(email.Attachments)
+	v.size(email)
+	return errors.Join(v.problems...)
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type validator struct {
+	problems []error
+}
+
+func (v *validator) add(field string, problem string) {
+	v.problems = append(v.problems, FieldError{Field: field, Problem: problem})
+}
+
+func (v *validator) required(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "is required")
+	}
+}
+
+// address checks a single address, if set
+func (v *validator) address(field string, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := mail.ParseAddress(value); err != nil {
+		v.add(field, fmt.Sprintf("invalid address %q", value))
+	}
+}
+
+// addresses checks a comma separated list of addresses, if set
+func (v *validator) addresses(field string, value string) int {
+	if value == "" {
+		return 0
+	}
+	list, err := mail.ParseAddressList(value)
+	if err != nil {
+		v.add(field, fmt.Sprintf("invalid address list %q", value))
+		return 0
+	}
+	return len(list)
+}
+
+func (v *validator) recipients(to string, cc string, bcc string) {
+	count := v.addresses("To", to) + v.addresses("Cc", cc) + v.addresses("Bcc", bcc)
+	if count > MaxRecipients {
+		v.add("To", fmt.Sprintf("%d recipients across To, Cc and Bcc, the limit is %d", count, MaxRecipients))
+	}
+}
+
+func (v *validator) headers(headers []Header) {
+	for i, header := range headers {
+		if !validHeaderName(header.Name) {
+			v.add(fmt.Sprintf("Headers[%d]", i), fmt.Sprintf("invalid header name %q", header.Name))
+		}
+	}
+}
+
+// validHeaderName reports whether name is a RFC 5322 field name:
+// printable ASCII, without spaces or colons
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] < 33 || name[i] > 126 || name[i] == ':' {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *validator) attachments(attachments []Attachment) {
+	for i, attachment := range attachments {
+		field := fmt.Sprintf("Attachments[%d]", i)
+		if attachment.Name == "" {
+			v.add(field, "Name is required")
+		}
+		if forbiddenAttachment(attachment.Name) {
+			v.add(field, fmt.Sprintf("forbidden file type %q", filepath.Ext(attachment.Name)))
+		}
+	}
+}
+
+// forbiddenAttachment reports whether Postmark refuses attachments called name
+func forbiddenAttachment(name string) bool {
+	return forbiddenExtensions[strings.ToLower(filepath.Ext(name))]
+}
+
+func (v *validator) size(email interface{}) {
+	payload, err := json.Marshal(email)
+	if err != nil {
+		v.add("Email", err.Error())
+		return
+	}
+	if len(payload) > MaxMessageSize {
+		v.add("Email", fmt.Sprintf("%d bytes, the limit is %d", len(payload), MaxMessageSize))
+	}
+}
@@ -0,0 +1,99 @@
+package postmark
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// validationFields lists the fields of the FieldErrors joined in err
+func validationFields(err error) []string {
+	var fields []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			var problem FieldError
+			if errors.As(err, &problem) {
+				fields = append(fields, problem.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestEmailValidate(t *testing.T) {
+	if err := testEmail.Validate(); err != nil {
+		t.Fatalf("Validate: %s", err.Error())
+	}
+
+	email := Email{
+		From:    "not an address",
+		ReplyTo: "a@example.com, nope",
+		Headers: []Header{{Name: "X Bad", Value: "1"}, {Name: "X-Good", Value: "1"}},
+		Attachments: []Attachment{
+			{Name: "invoice.pdf"},
+			{Name: "setup.EXE"},
+			{Name: "install.ps1"},
+		},
+	}
+
+	fields := validationFields(email.Validate())
+	want := []string{"From", "To", "ReplyTo", "HtmlBody", "Headers[0]", "Attachments[1]", "Attachments[2]"}
+	if strings.Join(fields, " ") != strings.Join(want, " ") {
+		t.Fatalf("Validate: wrong problems %v", fields)
+	}
+}
+
+func TestEmailValidateLimits(t *testing.T) {
+	to := make([]string, 30)
+	cc := make([]string, 21)
+	for i := range to {
+		to[i] = fmt.Sprintf("to%d@example.com", i)
+	}
+	for i := range cc {
+		cc[i] = fmt.Sprintf("cc%d@example.com", i)
+	}
+
+	email := Email{
+		From:     "sender@example.com",
+		To:       strings.Join(to, ","),
+		Cc:       strings.Join(cc, ","),
+		TextBody: strings.Repeat("a", MaxMessageSize),
+	}
+
+	fields := validationFields(email.Validate())
+	if strings.Join(fields, " ") != "To Email" {
+		t.Fatalf("Validate: wrong problems %v", fields)
+	}
+}
+
+func TestTemplatedEmailValidate(t *testing.T) {
+	email := testTemplatedEmail
+	if err := email.Validate(); err != nil {
+		t.Fatalf("Validate: %s", err.Error())
+	}
+
+	email.TemplateId = 0
+	fields := validationFields(email.Validate())
+	if strings.Join(fields, " ") != "TemplateId" {
+		t.Fatalf("Validate: wrong problems %v", fields)
+	}
+}
+
+func TestValidateSends(t *testing.T) {
+	client := NewClient("", "")
+	client.BaseURL = "http://127.0.0.1:0"
+	client.ValidateSends = true
+
+	_, err := client.SendEmail(Email{From: "sender@example.com"})
+
+	var problem FieldError
+	if !errors.As(err, &problem) || problem.Field != "To" {
+		t.Fatalf("SendEmail: should have failed validation, got %v", err)
+	}
+
+	_, err = client.SendTemplatedEmail(TemplatedEmail{To: "receiver@example.com", TemplateAlias: "welcome"})
+	if !errors.As(err, &problem) || problem.Field != "From" {
+		t.Fatalf("SendTemplatedEmail: should have failed validation, got %v", err)
+	}
+}
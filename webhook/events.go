@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/keighl/postmark"
+)
+
+// Record types sent by Postmark in the RecordType field
+const (
+	RecordTypeBounce             = "Bounce"
+	RecordTypeSpamComplaint      = "SpamComplaint"
+	RecordTypeOpen               = "Open"
+	RecordTypeClick              = "Click"
+	RecordTypeDelivery           = "Delivery"
+	RecordTypeSubscriptionChange = "SubscriptionChange"
+	// RecordTypeInbound isn't sent by Postmark, inbound payloads have no RecordType
+	RecordTypeInbound = "Inbound"
+)
+
+// ErrUnknownRecordType is returned by ParseEvent for payloads it can't identify
+var ErrUnknownRecordType = errors.New("webhook: unknown record type")
+
+// BounceEvent is POSTed when a message bounces
+type BounceEvent struct {
+	postmark.Bounce
+	// RecordType: Always RecordTypeBounce
+	RecordType string
+	// ServerID: ID of the server the message was sent through
+	ServerID int64
+	// From: Sender email address
+	From string
+	// Content: Full content of the bounce, if the webhook is set to include it
+	Content string
+	// Metadata: Metadata sent with the message
+	Metadata map[string]string
+}
+
+// SpamComplaintEvent is POSTed when a recipient marks a message as spam
+type SpamComplaintEvent struct {
+	postmark.Bounce
+	// RecordType: Always RecordTypeSpamComplaint
+	RecordType string
+	// ServerID: ID of the server the message was sent through
+	ServerID int64
+	// From: Sender email address
+	From string
+	// Content: Full content of the complaint, if the webhook is set to include it
+	Content string
+	// Metadata: Metadata sent with the message
+	Metadata map[string]string
+}
+
+// OpenEvent is POSTed when a recipient opens a message
+type OpenEvent struct {
+	postmark.Open
+	// RecordType: Always RecordTypeOpen
+	RecordType string
+	// Tag: Tag of the message
+	Tag string
+	// MessageStream: ID of the message stream the message was sent through
+	MessageStream string
+	// Metadata: Metadata sent with the message
+	Metadata map[string]string
+}
+
+// ClickEvent is POSTed when a recipient clicks a tracked link
+type ClickEvent struct {
+	postmark.Click
+	// Metadata: Metadata sent with the message
+	Metadata map[string]string
+}
+
+// DeliveryEvent is POSTed when a message is accepted by the recipient's mail server
+type DeliveryEvent struct {
+	// RecordType: Always RecordTypeDelivery
+	RecordType string
+	// ServerID: ID of the server the message was sent through
+	ServerID int64
+	// MessageID: ID of the message
+	MessageID string
+	// Recipient: Email address of the recipient
+	Recipient string
+	// DeliveredAt: Timestamp of the delivery
+	DeliveredAt time.Time
+	// Details: The response of the recipient's mail server
+	Details string
+	// Tag: Tag of the message
+	Tag string
+	// MessageStream: ID of the message stream the message was sent through
+	MessageStream string
+	// Metadata: Metadata sent with the message
+	Metadata map[string]string
+}
+
+// SubscriptionChangeEvent is POSTed when an address is suppressed or reactivated
+type SubscriptionChangeEvent struct {
+	// RecordType: Always RecordTypeSubscriptionChange
+	RecordType string
+	// ServerID: ID of the server
+	ServerID int64
+	// MessageID: ID of the message that caused the change, if any
+	MessageID string
+	// Recipient: The address whose subscription changed
+	Recipient string
+	// ChangedAt: Timestamp of the change
+	ChangedAt time.Time
+	// Origin: Who made the change
+	Origin postmark.SuppressionOrigin
+	// SuppressSending: True if the address is now suppressed, false if it was reactivated
+	SuppressSending bool
+	// SuppressionReason: Why the address was suppressed
+	SuppressionReason postmark.SuppressionReason
+	// Tag: Tag of the message
+	Tag string
+	// MessageStream: ID of the message stream
+	MessageStream string
+	// Metadata: Metadata sent with the message
+	Metadata map[string]string
+}
+
+// InboundEvent is POSTed when an inbound message is received
+type InboundEvent struct {
+	postmark.InboundMessage
+	// MessageStream: ID of the inbound message stream
+	MessageStream string
+}
+
+// ParseEvent decodes a webhook payload into one of the *Event types,
+// using its RecordType. Inbound payloads, which have no RecordType,
+// are recognized by their FromFull field.
+func ParseEvent(data []byte) (interface{}, error) {
+	var head struct {
+		RecordType string
+		FromFull   *json.RawMessage
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+
+	recordType := head.RecordType
+	if recordType == "" && head.FromFull != nil {
+		recordType = RecordTypeInbound
+	}
+
+	var event interface{}
+	switch recordType {
+	case RecordTypeBounce:
+		event = &BounceEvent{}
+	case RecordTypeSpamComplaint:
+		event = &SpamComplaintEvent{}
+	case RecordTypeOpen:
+		event = &OpenEvent{}
+	case RecordTypeClick:
+		event = &ClickEvent{}
+	case RecordTypeDelivery:
+		event = &DeliveryEvent{}
+	case RecordTypeSubscriptionChange:
+		event = &SubscriptionChangeEvent{}
+	case RecordTypeInbound:
+		event = &InboundEvent{}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownRecordType, head.RecordType)
+	}
+
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
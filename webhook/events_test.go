@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/keighl/postmark"
+)
+
+func TestParseBounce(t *testing.T) {
+	payload := `{
+	  "RecordType": "Bounce",
+	  "MessageStream": "outbound",
+	  "ID": 4323372036854775807,
+	  "Type": "HardBounce",
+	  "TypeCode": 1,
+	  "Name": "Hard bounce",
+	  "Tag": "Test",
+	  "MessageID": "883953f4-6105-42a2-a16a-77a8eac79483",
+	  "Metadata": {
+		"a_key": "a_value"
+	  },
+	  "ServerID": 23,
+	  "Description": "The server was unable to deliver your message (ex: unknown user, mailbox not found).",
+	  "Details": "Test bounce details",
+	  "Email": "john@example.com",
+	  "From": "sender@example.com",
+	  "BouncedAt": "2019-11-05T16:33:54.9070259Z",
+	  "DumpAvailable": true,
+	  "Inactive": true,
+	  "CanActivate": true,
+	  "Subject": "Test subject",
+	  "Content": "Return-Path:>\r\n"
+	}`
+
+	event, err := ParseEvent([]byte(payload))
+	if err != nil {
+		t.Fatalf("ParseEvent: %s", err.Error())
+	}
+
+	bounce, ok := event.(*BounceEvent)
+	if !ok {
+		t.Fatalf("ParseEvent: wrong event type %T", event)
+	}
+
+	if bounce.Type != postmark.BounceTypeHardBounce || bounce.Email != "john@example.com" {
+		t.Fatalf("ParseEvent: wrong bounce %v", bounce.Bounce)
+	}
+
+	if bounce.ServerID != 23 || bounce.Metadata["a_key"] != "a_value" {
+		t.Fatalf("ParseEvent: wrong webhook fields")
+	}
+}
+
+func TestParseSubscriptionChange(t *testing.T) {
+	payload := `{
+	  "RecordType": "SubscriptionChange",
+	  "MessageID": "00000000-0000-0000-0000-000000000000",
+	  "ServerID": 23,
+	  "MessageStream": "outbound",
+	  "ChangedAt": "2020-02-01T10:53:34.416071Z",
+	  "Recipient": "bounced-address@wildbit.com",
+	  "Origin": "Recipient",
+	  "SuppressSending": true,
+	  "SuppressionReason": "HardBounce",
+	  "Tag": "my-tag"
+	}`
+
+	event, err := ParseEvent([]byte(payload))
+	if err != nil {
+		t.Fatalf("ParseEvent: %s", err.Error())
+	}
+
+	change, ok := event.(*SubscriptionChangeEvent)
+	if !ok {
+		t.Fatalf("ParseEvent: wrong event type %T", event)
+	}
+
+	if !change.SuppressSending || change.SuppressionReason != postmark.SuppressionReasonHardBounce {
+		t.Fatalf("ParseEvent: wrong subscription change %v", change)
+	}
+}
+
+func TestParseInbound(t *testing.T) {
+	payload := `{
+	  "FromName": "Postmarkapp Support",
+	  "MessageStream": "inbound",
+	  "From": "support@postmarkapp.com",
+	  "FromFull": {
+		"Email": "support@postmarkapp.com",
+		"Name": "Postmarkapp Support"
+	  },
+	  "To": "\"Firstname Lastname\" <yourhash+SampleHash@inbound.postmarkapp.com>",
+	  "MessageID": "73e6d360-66eb-11e1-8e72-a8904824019b",
+	  "Subject": "Test subject"
+	}`
+
+	event, err := ParseEvent([]byte(payload))
+	if err != nil {
+		t.Fatalf("ParseEvent: %s", err.Error())
+	}
+
+	inbound, ok := event.(*InboundEvent)
+	if !ok {
+		t.Fatalf("ParseEvent: wrong event type %T", event)
+	}
+
+	if inbound.FromFull.Name != "Postmarkapp Support" || inbound.MessageStream != "inbound" {
+		t.Fatalf("ParseEvent: wrong inbound message %v", inbound)
+	}
+}
+
+func TestParseUnknown(t *testing.T) {
+	_, err := ParseEvent([]byte(`{"RecordType": "Teleport"}`))
+	if !errors.Is(err, ErrUnknownRecordType) {
+		t.Fatalf("ParseEvent: expected ErrUnknownRecordType, got %v", err)
+	}
+}
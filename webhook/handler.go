@@ -0,0 +1,154 @@
+// Package webhook receives Postmark webhooks
+//
+//	handler := webhook.NewHandler()
+//	handler.OnBounce(func(ctx context.Context, event webhook.BounceEvent) error {
+//		// ...
+//		return nil
+//	})
+//	http.Handle("/postmark", handler)
+//
+// A callback returning an error makes the handler answer 500, so Postmark retries the delivery later.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// DefaultMaxBodyBytes is the default limit on the size of a webhook payload.
+// Inbound payloads include attachments, so it's generous.
+const DefaultMaxBodyBytes = 50 << 20
+
+// Handler is an http.Handler that parses Postmark webhooks and dispatches
+// them to the registered callbacks. Events without a callback are acknowledged and dropped.
+type Handler struct {
+	// MaxBodyBytes limits the size of payloads. Defaults to DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+
+	onBounce             func(context.Context, BounceEvent) error
+	onSpamComplaint      func(context.Context, SpamComplaintEvent) error
+	onOpen               func(context.Context, OpenEvent) error
+	onClick              func(context.Context, ClickEvent) error
+	onDelivery           func(context.Context, DeliveryEvent) error
+	onSubscriptionChange func(context.Context, SubscriptionChangeEvent) error
+	onInbound            func(context.Context, InboundEvent) error
+}
+
+// NewHandler builds a new Handler pointer with no callbacks registered
+func NewHandler() *Handler {
+	return &Handler{
+		MaxBodyBytes: DefaultMaxBodyBytes,
+	}
+}
+
+// OnBounce registers the callback for bounce events
+func (handler *Handler) OnBounce(fn func(context.Context, BounceEvent) error) {
+	handler.onBounce = fn
+}
+
+// OnSpamComplaint registers the callback for spam complaint events
+func (handler *Handler) OnSpamComplaint(fn func(context.Context, SpamComplaintEvent) error) {
+	handler.onSpamComplaint = fn
+}
+
+// OnOpen registers the callback for open events
+func (handler *Handler) OnOpen(fn func(context.Context, OpenEvent) error) {
+	handler.onOpen = fn
+}
+
+// OnClick registers the callback for click events
+func (handler *Handler) OnClick(fn func(context.Context, ClickEvent) error) {
+	handler.onClick = fn
+}
+
+// OnDelivery registers the callback for delivery events
+func (handler *Handler) OnDelivery(fn func(context.Context, DeliveryEvent) error) {
+	handler.onDelivery = fn
+}
+
+// OnSubscriptionChange registers the callback for subscription change events
+func (handler *Handler) OnSubscriptionChange(fn func(context.Context, SubscriptionChangeEvent) error) {
+	handler.onSubscriptionChange = fn
+}
+
+// OnInbound registers the callback for inbound messages
+func (handler *Handler) OnInbound(fn func(context.Context, InboundEvent) error) {
+	handler.onInbound = fn
+}
+
+// ServeHTTP parses the webhook and calls the matching callback
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	limit := handler.MaxBodyBytes
+	if limit <= 0 {
+		limit = DefaultMaxBodyBytes
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, limit))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	event, err := ParseEvent(body)
+	if errors.Is(err, ErrUnknownRecordType) {
+		// Acknowledge, so Postmark doesn't keep retrying record types added after this package
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err := handler.dispatch(req.Context(), event); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *Handler) dispatch(ctx context.Context, event interface{}) error {
+	switch e := event.(type) {
+	case *BounceEvent:
+		if handler.onBounce != nil {
+			return handler.onBounce(ctx, *e)
+		}
+	case *SpamComplaintEvent:
+		if handler.onSpamComplaint != nil {
+			return handler.onSpamComplaint(ctx, *e)
+		}
+	case *OpenEvent:
+		if handler.onOpen != nil {
+			return handler.onOpen(ctx, *e)
+		}
+	case *ClickEvent:
+		if handler.onClick != nil {
+			return handler.onClick(ctx, *e)
+		}
+	case *DeliveryEvent:
+		if handler.onDelivery != nil {
+			return handler.onDelivery(ctx, *e)
+		}
+	case *SubscriptionChangeEvent:
+		if handler.onSubscriptionChange != nil {
+			return handler.onSubscriptionChange(ctx, *e)
+		}
+	case *InboundEvent:
+		if handler.onInbound != nil {
+			return handler.onInbound(ctx, *e)
+		}
+	}
+	return nil
+}
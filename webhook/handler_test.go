@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const openPayload = `{
+  "RecordType": "Open",
+  "MessageStream": "outbound",
+  "FirstOpen": true,
+  "Client": {
+	"Name": "Chrome 35.0.1916.153",
+	"Company": "Google",
+	"Family": "Chrome"
+  },
+  "OS": {
+	"Name": "OS X 10.7 Lion",
+	"Company": "Apple Computer, Inc.",
+	"Family": "OS X 10"
+  },
+  "Platform": "WebMail",
+  "UserAgent": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_7_5)",
+  "ReadSeconds": 5,
+  "Geo": {
+	"CountryISOCode": "RS",
+	"Country": "Serbia",
+	"City": "Belgrade",
+	"Coords": "44.8166,20.4721",
+	"IP": "188.2.95.4"
+  },
+  "MessageID": "883953f4-6105-42a2-a16a-77a8eac79483",
+  "ReceivedAt": "2019-11-05T16:33:54.9070259Z",
+  "Tag": "welcome-email",
+  "Recipient": "john@example.com"
+}`
+
+func post(handler http.Handler, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/postmark", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	handler := NewHandler()
+
+	var got OpenEvent
+	handler.OnOpen(func(ctx context.Context, event OpenEvent) error {
+		got = event
+		return nil
+	})
+
+	rec := post(handler, openPayload)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Handler: wrong status (%d)", rec.Code)
+	}
+
+	if got.Recipient != "john@example.com" || got.Client.Family != "Chrome" || got.ReadSeconds != 5 {
+		t.Fatalf("Handler: wrong open event %v", got)
+	}
+}
+
+func TestHandlerCallbackError(t *testing.T) {
+	handler := NewHandler()
+	handler.OnOpen(func(ctx context.Context, event OpenEvent) error {
+		return errors.New("database is down")
+	})
+
+	rec := post(handler, openPayload)
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("Handler: wrong status (%d)", rec.Code)
+	}
+}
+
+func TestHandlerUnregisteredAndUnknown(t *testing.T) {
+	handler := NewHandler()
+
+	if rec := post(handler, openPayload); rec.Code != http.StatusOK {
+		t.Fatalf("Handler: events without a callback should be acknowledged (%d)", rec.Code)
+	}
+
+	if rec := post(handler, `{"RecordType": "Teleport"}`); rec.Code != http.StatusOK {
+		t.Fatalf("Handler: unknown record types should be acknowledged (%d)", rec.Code)
+	}
+}
+
+func TestHandlerBadRequests(t *testing.T) {
+	handler := NewHandler()
+
+	if rec := post(handler, `{not json`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("Handler: wrong status for bad JSON (%d)", rec.Code)
+	}
+
+	req := httptest.NewRequest("GET", "/postmark", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("Handler: wrong status for GET (%d)", rec.Code)
+	}
+
+	handler.MaxBodyBytes = 10
+	if rec := post(handler, openPayload); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("Handler: wrong status for large body (%d)", rec.Code)
+	}
+}
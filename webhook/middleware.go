@@ -0,0 +1,244 @@
+package webhook
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// BasicAuth wraps next, rejecting requests that don't carry the given
+// basic auth credentials. The comparison is constant-time.
+func BasicAuth(username string, password string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		user, pass, ok := req.BasicAuth()
+		// Evaluate both comparisons, so timing doesn't reveal which one failed
+		userOK := subtle.ConstantTimeCompare([]byte(user), []byte(username))
+		passOK := subtle.ConstantTimeCompare([]byte(pass), []byte(password))
+		if !ok || userOK&passOK != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="postmark"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// AllowList wraps next, rejecting requests that don't come from one of
+// the CIDR ranges. Postmark publishes the addresses its webhooks are sent
+// from at https://postmarkapp.com/support/article/800-ips-for-firewalls
+//
+// The client address is taken from the request's RemoteAddr. Behind a
+// proxy, rewrite RemoteAddr from a header the proxy sets before this runs.
+func AllowList(cidrs []string, next http.Handler) (http.Handler, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			// Accept bare addresses too
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, err
+			}
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		}
+		networks = append(networks, network)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		host, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			host = req.RemoteAddr
+		}
+		ip := net.ParseIP(host)
+
+		for _, network := range networks {
+			if ip != nil && network.Contains(ip) {
+				next.ServeHTTP(w, req)
+				return
+			}
+		}
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	}), nil
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// IdempotencyStore remembers which webhooks have already been handled
+type IdempotencyStore interface {
+	// Seen reports whether key was recorded
+	Seen(key string) (bool, error)
+	// Record records key, once its webhook was handled
+	Record(key string) error
+}
+
+// Deduplicate wraps next, acknowledging without calling next any webhook
+// already handled, according to store. A webhook only counts as handled
+// once next answers 2xx, so Postmark's retries of failed ones get through.
+// Payloads without a MessageID are always passed along.
+//
+// Payloads over maxBodyBytes are refused with 413; zero means DefaultMaxBodyBytes.
+//
+// Two copies of a webhook arriving at the same time can both be handled.
+func Deduplicate(store IdempotencyStore, maxBodyBytes int64, next http.Handler) http.Handler {
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = DefaultMaxBodyBytes
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodyBytes))
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		key := idempotencyKey(body)
+		if key == "" {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		seen, err := store.Seen(key)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if seen {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, req)
+		if rec.status >= 200 && rec.status <= 299 {
+			// Worst case, the next copy is handled again
+			store.Record(key)
+		}
+	})
+}
+
+// idempotencyKey builds the deduplication key of a payload, or "" if it has no MessageID.
+// Besides the message and the record type, it holds whatever tells apart
+// the distinct events of a message: the recipient of a delivery, the bounce,
+// the time of each open and click, the link clicked, and so on.
+func idempotencyKey(body []byte) string {
+	var head struct {
+		RecordType   string
+		MessageID    string
+		ID           json.Number
+		Recipient    string
+		Email        string
+		ReceivedAt   string
+		DeliveredAt  string
+		BouncedAt    string
+		ChangedAt    string
+		OriginalLink string
+	}
+	if err := json.Unmarshal(body, &head); err != nil || head.MessageID == "" {
+		return ""
+	}
+	recordType := head.RecordType
+	if recordType == "" {
+		recordType = RecordTypeInbound
+	}
+	return strings.Join([]string{
+		recordType, head.MessageID, string(head.ID), head.Recipient, head.Email,
+		head.ReceivedAt, head.DeliveredAt, head.BouncedAt, head.ChangedAt, head.OriginalLink,
+	}, "\x00")
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status  int
+	written bool
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	if !rec.written {
+		rec.status = status
+		rec.written = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	rec.written = true
+	return rec.ResponseWriter.Write(b)
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// MemoryStore is an in-memory IdempotencyStore that remembers the most
+// recent keys, evicting the least recently seen one when full. It's only
+// suitable for a single process.
+type MemoryStore struct {
+	size int
+
+	mu    sync.Mutex
+	order *list.List
+	keys  map[string]*list.Element
+}
+
+// NewMemoryStore builds a MemoryStore pointer remembering up to size keys
+func NewMemoryStore(size int) *MemoryStore {
+	if size < 1 {
+		size = 1
+	}
+	return &MemoryStore{
+		size:  size,
+		order: list.New(),
+		keys:  make(map[string]*list.Element),
+	}
+}
+
+// Seen reports whether key was recorded
+func (store *MemoryStore) Seen(key string) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	element, ok := store.keys[key]
+	if ok {
+		store.order.MoveToFront(element)
+	}
+	return ok, nil
+}
+
+// Record records key, evicting the least recently seen key if the store is full
+func (store *MemoryStore) Record(key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if element, ok := store.keys[key]; ok {
+		store.order.MoveToFront(element)
+		return nil
+	}
+
+	store.keys[key] = store.order.PushFront(key)
+	if store.order.Len() > store.size {
+		oldest := store.order.Back()
+		store.order.Remove(oldest)
+		delete(store.keys, oldest.Value.(string))
+	}
+	return nil
+}
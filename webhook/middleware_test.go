@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func TestBasicAuth(t *testing.T) {
+	handler := BasicAuth("postmark", "s3cret", okHandler)
+
+	req := httptest.NewRequest("POST", "/postmark", nil)
+	req.SetBasicAuth("postmark", "s3cret")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("BasicAuth: wrong status (%d)", rec.Code)
+	}
+
+	req = httptest.NewRequest("POST", "/postmark", nil)
+	req.SetBasicAuth("postmark", "wrong")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("BasicAuth: wrong password should be rejected (%d)", rec.Code)
+	}
+
+	rec = post(handler, "{}")
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("BasicAuth: missing credentials should be rejected (%d)", rec.Code)
+	}
+}
+
+func TestAllowList(t *testing.T) {
+	handler, err := AllowList([]string{"50.31.156.0/24", "3.134.147.250"}, okHandler)
+	if err != nil {
+		t.Fatalf("AllowList: %s", err.Error())
+	}
+
+	for addr, want := range map[string]int{
+		"50.31.156.6:4321":  http.StatusOK,
+		"3.134.147.250:443": http.StatusOK,
+		"3.134.147.251:443": http.StatusForbidden,
+		"192.0.2.1:1234":    http.StatusForbidden,
+		"garbage":           http.StatusForbidden,
+	} {
+		req := httptest.NewRequest("POST", "/postmark", nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Fatalf("AllowList: wrong status for %s (%d)", addr, rec.Code)
+		}
+	}
+
+	if _, err := AllowList([]string{"not-an-ip"}, okHandler); err == nil {
+		t.Fatalf("AllowList: should have rejected a bad CIDR")
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	calls := 0
+	status := http.StatusOK
+	handler := Deduplicate(NewMemoryStore(10), 0, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	}))
+
+	// A failed delivery is retried
+	status = http.StatusInternalServerError
+	post(handler, openPayload)
+	status = http.StatusOK
+	post(handler, openPayload)
+	if calls != 2 {
+		t.Fatalf("Deduplicate: failed delivery should be handled again (%d calls)", calls)
+	}
+
+	// A replay is dropped
+	rec := post(handler, openPayload)
+	if rec.Code != http.StatusOK || calls != 2 {
+		t.Fatalf("Deduplicate: replay should be acknowledged and dropped (%d, %d calls)", rec.Code, calls)
+	}
+
+	// Same message, different record type
+	post(handler, strings.Replace(openPayload, `"Open"`, `"Click"`, 1))
+	if calls != 3 {
+		t.Fatalf("Deduplicate: other record types should be handled (%d calls)", calls)
+	}
+
+	// Same message, another open
+	post(handler, strings.Replace(openPayload, "16:33:54", "16:40:12", 1))
+	if calls != 4 {
+		t.Fatalf("Deduplicate: repeat opens should be handled (%d calls)", calls)
+	}
+
+	// Same message, another recipient
+	post(handler, strings.Replace(openPayload, "john@example.com", "jane@example.com", 1))
+	if calls != 5 {
+		t.Fatalf("Deduplicate: other recipients should be handled (%d calls)", calls)
+	}
+}
+
+func TestDeduplicateMaxBodyBytes(t *testing.T) {
+	handler := Deduplicate(NewMemoryStore(10), 16, okHandler)
+
+	rec := post(handler, openPayload)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("Deduplicate: wrong status (%d)", rec.Code)
+	}
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	store := NewMemoryStore(2)
+	store.Record("a")
+	store.Record("b")
+	store.Seen("a")
+	store.Record("c") // evicts b
+
+	if seen, _ := store.Seen("a"); !seen {
+		t.Fatalf("MemoryStore: a should still be remembered")
+	}
+	if seen, _ := store.Seen("b"); seen {
+		t.Fatalf("MemoryStore: b should have been evicted")
+	}
+	if seen, _ := store.Seen("d"); seen {
+		t.Fatalf("MemoryStore: d was never recorded")
+	}
+}
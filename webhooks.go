@@ -0,0 +1,177 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Webhook is a URL Postmark POSTs events of a message stream to
+type Webhook struct {
+	// ID: ID of webhook
+	ID int64 `json:",omitempty"`
+	// Url: REQUIRED The URL events are POSTed to
+	Url string
+	// MessageStream: ID of the message stream the webhook belongs to. Defaults to the server's transactional stream.
+	MessageStream string `json:",omitempty"`
+	// HttpAuth: Optional basic auth credentials sent with each POST
+	HttpAuth *WebhookHttpAuth `json:",omitempty"`
+	// HttpHeaders: Optional custom headers sent with each POST
+	HttpHeaders []Header `json:",omitempty"`
+	// Triggers: Which events are POSTed
+	Triggers WebhookTriggers
+}
+
+// WebhookHttpAuth holds the basic auth credentials sent with webhook POSTs
+type WebhookHttpAuth struct {
+	Username string
+	Password string
+}
+
+// WebhookTriggers holds the settings of each webhook event type
+type WebhookTriggers struct {
+	Open               WebhookOpenTrigger
+	Click              WebhookTrigger
+	Delivery           WebhookTrigger
+	Bounce             WebhookContentTrigger
+	SpamComplaint      WebhookContentTrigger
+	SubscriptionChange WebhookTrigger
+}
+
+// WebhookTrigger enables an event type
+type WebhookTrigger struct {
+	// Enabled: Whether the event is POSTed
+	Enabled bool
+}
+
+// WebhookOpenTrigger enables open events
+type WebhookOpenTrigger struct {
+	// Enabled: Whether the event is POSTed
+	Enabled bool
+	// PostFirstOpenOnly: If true, only the first open by a particular recipient is POSTed
+	PostFirstOpenOnly bool
+}
+
+// WebhookContentTrigger enables an event type that can include the message content
+type WebhookContentTrigger struct {
+	// Enabled: Whether the event is POSTed
+	Enabled bool
+	// IncludeContent: Whether the full content of the message is included
+	IncludeContent bool
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+type webhooksResponse struct {
+	Webhooks []Webhook
+}
+
+// ListWebhooks fetches the webhooks on the server, optionally only those of the message stream with streamID
+func (client *Client) ListWebhooks(streamID string) ([]Webhook, error) {
+	return client.ListWebhooksContext(context.Background(), streamID)
+}
+
+// ListWebhooksContext is the context-aware version of ListWebhooks.
+func (client *Client) ListWebhooksContext(ctx context.Context, streamID string) ([]Webhook, error) {
+	res := webhooksResponse{}
+
+	values := &url.Values{}
+	if streamID != "" {
+		values.Add("MessageStream", streamID)
+	}
+
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("webhooks?%s", values.Encode()),
+		TokenType: server_token,
+	}, &res)
+	return res.Webhooks, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// GetWebhook fetches a specific webhook via webhookID
+func (client *Client) GetWebhook(webhookID int64) (Webhook, error) {
+	return client.GetWebhookContext(context.Background(), webhookID)
+}
+
+// GetWebhookContext is the context-aware version of GetWebhook.
+func (client *Client) GetWebhookContext(ctx context.Context, webhookID int64) (Webhook, error) {
+	res := Webhook{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "GET",
+		Path:      fmt.Sprintf("webhooks/%d", webhookID),
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// CreateWebhook adds a new webhook to the server
+func (client *Client) CreateWebhook(webhook Webhook) (Webhook, error) {
+	return client.CreateWebhookContext(context.Background(), webhook)
+}
+
+// CreateWebhookContext is the context-aware version of CreateWebhook.
+func (client *Client) CreateWebhookContext(ctx context.Context, webhook Webhook) (Webhook, error) {
+	res := Webhook{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "POST",
+		Path:      "webhooks",
+		Payload:   webhook,
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// EditWebhook updates details for a specific webhook with webhookID
+// Triggers are always sent, so fetch the webhook first to only change some of them.
+// The message stream of a webhook can't be changed.
+func (client *Client) EditWebhook(webhookID int64, webhook Webhook) (Webhook, error) {
+	return client.EditWebhookContext(context.Background(), webhookID, webhook)
+}
+
+// EditWebhookContext is the context-aware version of EditWebhook.
+func (client *Client) EditWebhookContext(ctx context.Context, webhookID int64, webhook Webhook) (Webhook, error) {
+	res := Webhook{}
+	webhook.ID = 0
+	webhook.MessageStream = ""
+	err := client.doRequest(ctx, parameters{
+		Method:    "PUT",
+		Path:      fmt.Sprintf("webhooks/%d", webhookID),
+		Payload:   webhook,
+		TokenType: server_token,
+	}, &res)
+	return res, err
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// DeleteWebhook removes a webhook (with webhookID) from the server
+func (client *Client) DeleteWebhook(webhookID int64) error {
+	return client.DeleteWebhookContext(context.Background(), webhookID)
+}
+
+// DeleteWebhookContext is the context-aware version of DeleteWebhook.
+func (client *Client) DeleteWebhookContext(ctx context.Context, webhookID int64) error {
+	res := APIError{}
+	err := client.doRequest(ctx, parameters{
+		Method:    "DELETE",
+		Path:      fmt.Sprintf("webhooks/%d", webhookID),
+		TokenType: server_token,
+	}, &res)
+
+	if res.ErrorCode != 0 {
+		return res
+	}
+
+	return err
+}
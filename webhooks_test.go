@@ -0,0 +1,159 @@
+package postmark
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"goji.io/pat"
+)
+
+var webhookJSON = `{
+  "ID": 1234567,
+  "Url": "https://www.example.com/webhook-test-tracking",
+  "MessageStream": "outbound",
+  "HttpAuth": {
+    "Username": "user",
+    "Password": "pass"
+  },
+  "HttpHeaders": [
+    {
+      "Name": "name",
+      "Value": "value"
+    }
+  ],
+  "Triggers": {
+    "Open": {
+      "Enabled": true,
+      "PostFirstOpenOnly": false
+    },
+    "Click": {
+      "Enabled": true
+    },
+    "Delivery": {
+      "Enabled": true
+    },
+    "Bounce": {
+      "Enabled": false,
+      "IncludeContent": false
+    },
+    "SpamComplaint": {
+      "Enabled": false,
+      "IncludeContent": false
+    },
+    "SubscriptionChange": {
+      "Enabled": true
+    }
+  }
+}`
+
+func TestListWebhooks(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/webhooks"), func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("MessageStream") != "outbound" {
+			t.Errorf("ListWebhooks: wrong MessageStream %s", req.URL.Query().Get("MessageStream"))
+		}
+		w.Write([]byte(`{"Webhooks": [` + webhookJSON + `]}`))
+	})
+
+	res, err := client.ListWebhooks("outbound")
+	if err != nil {
+		t.Fatalf("ListWebhooks: %s", err.Error())
+	}
+
+	if len(res) != 1 || res[0].ID != 1234567 {
+		t.Fatalf("ListWebhooks: wrong webhooks %v", res)
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	tMux.HandleFunc(pat.Get("/webhooks/:webhookID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(webhookJSON))
+	})
+
+	res, err := client.GetWebhook(1234567)
+	if err != nil {
+		t.Fatalf("GetWebhook: %s", err.Error())
+	}
+
+	if res.HttpAuth == nil || res.HttpAuth.Username != "user" {
+		t.Fatalf("GetWebhook: wrong HttpAuth!")
+	}
+
+	if !res.Triggers.SubscriptionChange.Enabled || res.Triggers.Bounce.Enabled {
+		t.Fatalf("GetWebhook: wrong Triggers!")
+	}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	tMux.HandleFunc(pat.Post("/webhooks"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(webhookJSON))
+	})
+
+	res, err := client.CreateWebhook(Webhook{
+		Url:           "https://www.example.com/webhook-test-tracking",
+		MessageStream: "outbound",
+		Triggers: WebhookTriggers{
+			Open:     WebhookOpenTrigger{Enabled: true},
+			Click:    WebhookTrigger{Enabled: true},
+			Delivery: WebhookTrigger{Enabled: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %s", err.Error())
+	}
+
+	if res.MessageStream != "outbound" {
+		t.Fatalf("CreateWebhook: wrong MessageStream!: %s", res.MessageStream)
+	}
+}
+
+func TestEditWebhook(t *testing.T) {
+	tMux.HandleFunc(pat.Put("/webhooks/:webhookID"), func(w http.ResponseWriter, req *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&payload)
+		if _, ok := payload["MessageStream"]; ok {
+			t.Errorf("EditWebhook: MessageStream should not be sent")
+		}
+		w.Write([]byte(webhookJSON))
+	})
+
+	res, err := client.EditWebhook(1234567, Webhook{
+		Url:           "https://www.example.com/webhook-test-tracking",
+		MessageStream: "outbound",
+	})
+	if err != nil {
+		t.Fatalf("EditWebhook: %s", err.Error())
+	}
+
+	if res.Url != "https://www.example.com/webhook-test-tracking" {
+		t.Fatalf("EditWebhook: wrong Url!: %s", res.Url)
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	responseJSON := `{
+	  "ErrorCode": 0,
+	  "Message": "Webhook 1234 removed."
+	}`
+
+	tMux.HandleFunc(pat.Delete("/webhooks/:webhookID"), func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(responseJSON))
+	})
+
+	// Success
+	err := client.DeleteWebhook(1234)
+	if err != nil {
+		t.Fatalf("DeleteWebhook: %s", err.Error())
+	}
+
+	// Failure
+	responseJSON = `{
+	  "ErrorCode": 402,
+	  "Message": "Invalid JSON"
+	}`
+
+	err = client.DeleteWebhook(1234)
+	if err == nil {
+		t.Fatalf("DeleteWebhook should have failed")
+	}
+}